@@ -4,13 +4,16 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
 	"strings"
 	"syscall"
 	"time"
+	"watchlist-app/internal/grpcserver"
 	"watchlist-app/internal/router"
+	"watchlist-app/internal/service"
 	"watchlist-app/pkg/config"
 	"watchlist-app/pkg/database"
 	"watchlist-app/pkg/errors"
@@ -27,6 +30,10 @@ func main() {
 		log.Fatalf("Failed to load config: %v", err)
 	}
 
+	if cfg.Auth.JWTSecret == "" {
+		log.Fatal("JWT signing key is not configured (auth.jwt_secret / APP_AUTH_JWT_SECRET)")
+	}
+
 	// DB接続
 	db, err := database.New(cfg)
 	if err != nil {
@@ -40,6 +47,16 @@ func main() {
 		log.Fatalf("Failed to run migrations: %v", err)
 	}
 
+	// ゴミ箱の定期整理（保持期間を過ぎた作品を完全削除）
+	purgeCtx, stopPurger := context.WithCancel(ctx)
+	defer stopPurger()
+	trashService := service.NewTrashService(db.Client, cfg.Trash.Retention)
+	go trashService.RunPurger(purgeCtx, cfg.Trash.PurgeInterval, log.Printf)
+
+	// Webhook の送信（送信待ち・再送予定の配信を定期的に送る）
+	webhookService := service.NewWebhookService(db.Client, cfg.Webhook.Timeout, cfg.Webhook.MaxAttempts)
+	go webhookService.RunDispatcher(purgeCtx, cfg.Webhook.DeliveryInterval, log.Printf)
+
 	// Echo インスタンス作成
 	e := echo.New()
 
@@ -58,11 +75,18 @@ func main() {
 			http.MethodGet,
 			http.MethodPost,
 			http.MethodPut,
+			http.MethodPatch,
 			http.MethodDelete,
 			http.MethodOptions,
 		},
 		AllowHeaders: []string{
 			"Content-Type",
+			"Authorization",
+			"If-Match",
+		},
+		// 楽観的排他制御のため ETag をブラウザから参照できるようにする
+		ExposeHeaders: []string{
+			"ETag",
 		},
 	}))
 
@@ -75,7 +99,7 @@ func main() {
 	})
 
 	// ルート設定
-	router.SetupRoutes(e, db.Client)
+	router.SetupRoutes(e, db.Client, cfg)
 
 	// サーバー開始
 	serverAddr := fmt.Sprintf("%s:%s", cfg.Server.Host, cfg.Server.Port)
@@ -89,20 +113,49 @@ func main() {
 		}
 	}()
 
+	// gRPC サーバー開始（REST と同じサービス層を別ポートで提供）
+	grpcAddr := fmt.Sprintf("%s:%s", cfg.Server.Host, cfg.Server.GRPCPort)
+	listener, err := net.Listen("tcp", grpcAddr)
+	if err != nil {
+		log.Fatalf("Failed to listen for gRPC: %v", err)
+	}
+	grpcServer := grpcserver.New(db.Client, cfg)
+	log.Printf("Starting gRPC server on %s", grpcAddr)
+
+	go func() {
+		if err := grpcServer.Serve(listener); err != nil {
+			log.Fatalf("Failed to start gRPC server: %v", err)
+		}
+	}()
+
 	// シグナル待機
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
 	log.Println("Shutting down server...")
+	stopPurger()
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
+	// 処理中の RPC の完了を待ち、タイムアウトした場合は強制終了する
+	grpcStopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(grpcStopped)
+	}()
+
 	if err := e.Shutdown(ctx); err != nil {
 		log.Fatalf("Failed to shutdown server: %v", err)
 	}
 
+	select {
+	case <-grpcStopped:
+	case <-ctx.Done():
+		grpcServer.Stop()
+	}
+
 	log.Println("Server stopped")
 }
 
@@ -0,0 +1,29 @@
+package dto
+
+import (
+	"time"
+
+	"watchlist-app/pkg/audit"
+)
+
+// 変更履歴レスポンス
+type AuditEntryResponse struct {
+	ID        int           `json:"id"`
+	Revision  int           `json:"revision"`
+	Action    string        `json:"action"`
+	ActorID   *int          `json:"actor_id"`
+	Changes   audit.Changes `json:"changes,omitempty"`
+	CreatedAt time.Time     `json:"created_at"`
+}
+
+type AuditEntriesResponse struct {
+	Data  []*AuditEntryResponse `json:"data"`
+	Count int                   `json:"count"`
+}
+
+// リビジョン間の差分レスポンス（old が from、new が to 時点の値）
+type RevisionDiffResponse struct {
+	From    int           `json:"from"`
+	To      int           `json:"to"`
+	Changes audit.Changes `json:"changes"`
+}
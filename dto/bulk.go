@@ -0,0 +1,38 @@
+package dto
+
+import "encoding/json"
+
+// 作品の一括操作リクエスト
+type BulkMoviesRequest struct {
+	// atomic（既定）: 1件でも失敗した場合は全ての操作を取り消す / partial: 成功した操作のみ反映する
+	Mode       string                  `json:"mode" validate:"omitempty,oneof=atomic partial"`
+	Operations []*BulkOperationRequest `json:"operations" validate:"required,min=1,max=100,dive,required"`
+}
+
+// 一括操作の1件分
+type BulkOperationRequest struct {
+	Op string `json:"op" validate:"required,oneof=create update delete"`
+	// update・delete の対象
+	ID int `json:"id"`
+	// update・delete では必須。If-Match と同様に、作品のバージョンが一致する場合のみ更新・削除する
+	Version int `json:"version" validate:"omitempty,min=1"`
+	// create は CreateMovieRequest、update は UpdateMovieRequest の形式
+	Movie json.RawMessage `json:"movie"`
+}
+
+// 一括操作の1件分の結果
+type BulkResultResponse struct {
+	// リクエストの operations での位置（0始まり）
+	Index  int            `json:"index"`
+	Op     string         `json:"op"`
+	Status int            `json:"status"`
+	ID     int            `json:"id,omitempty"`
+	Data   *MovieResponse `json:"data,omitempty"`
+	Error  string         `json:"error,omitempty"`
+}
+
+type BulkMoviesResponse struct {
+	Data      []*BulkResultResponse `json:"data"`
+	Succeeded int                   `json:"succeeded"`
+	Failed    int                   `json:"failed"`
+}
@@ -0,0 +1,48 @@
+package dto
+
+import "time"
+
+// シーズン作成リクエスト
+type CreateSeasonRequest struct {
+	Number       int    `json:"number" validate:"required,min=1"`
+	Title        string `json:"title"`
+	EpisodeCount int    `json:"episode_count" validate:"required,min=1,max=1000"`
+}
+
+// 指定話数までまとめて視聴済みにするリクエスト
+type WatchEpisodesRequest struct {
+	Season  int `json:"season" validate:"required,min=1"`
+	Episode int `json:"episode" validate:"required,min=1"`
+}
+
+// シーズンレスポンス
+type SeasonResponse struct {
+	ID       int                `json:"id"`
+	Number   int                `json:"number"`
+	Title    string             `json:"title,omitempty"`
+	Episodes []*EpisodeResponse `json:"episodes"`
+}
+
+// エピソードレスポンス
+type EpisodeResponse struct {
+	ID        int        `json:"id"`
+	Number    int        `json:"number"`
+	Title     string     `json:"title,omitempty"`
+	Watched   bool       `json:"watched"`
+	WatchedAt *time.Time `json:"watched_at,omitempty"`
+}
+
+// 視聴進捗
+type ProgressResponse struct {
+	WatchedEpisodes int     `json:"watched_episodes"`
+	TotalEpisodes   int     `json:"total_episodes"`
+	Percentage      float64 `json:"percentage"`
+}
+
+type SeasonsResponse struct {
+	Data []*SeasonResponse `json:"data"`
+}
+
+type SeasonDetailResponse struct {
+	Data *SeasonResponse `json:"data"`
+}
@@ -0,0 +1,8 @@
+package dto
+
+// GraphQL リクエスト
+type GraphQLRequest struct {
+	Query         string         `json:"query" validate:"required"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
@@ -0,0 +1,44 @@
+package dto
+
+import "time"
+
+// CSV インポートのフォーム項目（CSV は file で送信する）
+type ImportRequest struct {
+	Source string `form:"source" validate:"required,oneof=watchlist letterboxd filmarks imdb"`
+	// false を指定した場合のみ取り込む（既定はプレビューのみ）
+	DryRun string `form:"dry_run" validate:"omitempty,oneof=true false"`
+	// 取り込む作品の視聴ステータス（省略時は CSV の値、なければ評価・視聴日があれば completed、なければ want_to_watch）
+	WatchStatus string `form:"watch_status" validate:"omitempty,oneof=want_to_watch watching completed dropped"`
+}
+
+// CSV の1行分のプレビュー・取り込み結果
+type ImportItemResponse struct {
+	Line        int        `json:"line"`
+	Title       string     `json:"title"`
+	ReleaseYear int        `json:"release_year,omitempty"`
+	MediaType   string     `json:"media_type,omitempty"`
+	Rating      int        `json:"rating,omitempty"`
+	WatchedAt   *time.Time `json:"watched_at,omitempty"`
+	Review      string     `json:"review,omitempty"`
+	// new: 新規 / duplicate: 登録済みの作品または CSV 内の前の行と重複 / invalid: 値の誤り
+	Status string `json:"status"`
+	// 重複している登録済みの作品（CSV 内の重複の場合は未設定）
+	DuplicateOf int `json:"duplicate_of,omitempty"`
+	// 取り込み時に作成された作品
+	MovieID int    `json:"movie_id,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+type ImportSummaryResponse struct {
+	Total     int `json:"total"`
+	New       int `json:"new"`
+	Duplicate int `json:"duplicate"`
+	Invalid   int `json:"invalid"`
+	Imported  int `json:"imported"`
+}
+
+type ImportResponse struct {
+	Data    []*ImportItemResponse  `json:"data"`
+	Summary *ImportSummaryResponse `json:"summary"`
+	DryRun  bool                   `json:"dry_run"`
+}
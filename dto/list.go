@@ -0,0 +1,48 @@
+package dto
+
+import "time"
+
+// リスト作成・更新リクエスト
+type ListRequest struct {
+	Name        string `json:"name" validate:"required,max=100"`
+	Description string `json:"description"`
+}
+
+// リストへの作品追加リクエスト
+type AddListMovieRequest struct {
+	MovieID int `json:"movie_id" validate:"required,min=1"`
+	// 挿入位置（0始まり）。省略時は末尾に追加
+	Position *int `json:"position" validate:"omitempty,min=0"`
+}
+
+// リスト内の並び替えリクエスト（リスト内の全作品IDを新しい順序で指定）
+type ReorderListRequest struct {
+	MovieIDs []int `json:"movie_ids" validate:"required,dive,min=1"`
+}
+
+// リストレスポンス
+type ListResponse struct {
+	ID          int       `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	MovieCount  int       `json:"movie_count"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+
+	Entries []*ListEntryResponse `json:"entries,omitempty"`
+}
+
+// リスト内の作品
+type ListEntryResponse struct {
+	Position int            `json:"position"`
+	AddedAt  time.Time      `json:"added_at"`
+	Movie    *MovieResponse `json:"movie"`
+}
+
+type ListsResponse struct {
+	Data []*ListResponse `json:"data"`
+}
+
+type ListDetailResponse struct {
+	Data *ListResponse `json:"data"`
+}
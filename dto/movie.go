@@ -6,23 +6,46 @@ import "time"
 type CreateMovieRequest struct {
 	Title       string `json:"title" validate:"required"`
 	Description string `json:"description"`
-	Genre       string `json:"genre"`
+	// タグとしても登録するため、タグ名と同じく50文字まででカンマを含まない
+	Genre       string `json:"genre" validate:"max=50,excludesall=0x2C"`
 	ReleaseYear int    `json:"release_year"`
 	PosterURL   string `json:"poster_url"`
 	MediaType   string `json:"media_type" validate:"oneof=movie tv_series documentary anime"`
+	// タグ名の一覧（未登録のタグは自動作成。CSV でカンマ区切りにするため、タグ名にカンマは使えない）
+	Tags []string `json:"tags" validate:"omitempty,max=20,dive,required,max=50,excludesall=0x2C"`
 }
 
 // 映画更新リクエスト
 type UpdateMovieRequest struct {
 	Title       string `json:"title"`
 	Description string `json:"description"`
-	Genre       string `json:"genre"`
+	Genre       string `json:"genre" validate:"max=50,excludesall=0x2C"`
 	ReleaseYear int    `json:"release_year"`
 	PosterURL   string `json:"poster_url"`
 	MediaType   string `json:"media_type" validate:"omitempty,oneof=movie tv_series documentary anime"`
 	WatchStatus string `json:"watch_status" validate:"omitempty,oneof=want_to_watch watching completed dropped"`
 	Rating      int    `json:"rating" validate:"omitempty,min=1,max=5"`
 	Review      string `json:"review"`
+	// 指定時はタグを置き換える（省略時は変更しない、空配列で全て外す）
+	Tags []string `json:"tags" validate:"omitempty,max=20,dive,required,max=50,excludesall=0x2C"`
+}
+
+// 映画部分更新リクエスト（JSON Merge Patch）
+// 省略したフィールドは変更せず、null を指定したフィールドはクリアする
+type PatchMovieRequest struct {
+	Title       PatchField[string] `json:"title"`
+	Description PatchField[string] `json:"description"`
+	Genre       PatchField[string] `json:"genre"`
+	ReleaseYear PatchField[int]    `json:"release_year"`
+	PosterURL   PatchField[string] `json:"poster_url"`
+	MediaType   PatchField[string] `json:"media_type"`
+	WatchStatus PatchField[string] `json:"watch_status"`
+	Rating      PatchField[int]    `json:"rating"`
+	Review      PatchField[string] `json:"review"`
+	// 日時を指定した場合はその日時の視聴記録を追加する
+	WatchedAt PatchField[time.Time] `json:"watched_at"`
+	// 指定時はタグを置き換える（null・空配列で全て外す）
+	Tags PatchField[[]string] `json:"tags"`
 }
 
 // 映画レスポンス
@@ -40,6 +63,20 @@ type MovieResponse struct {
 	WatchedAt   time.Time `json:"watched_at,omitempty"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
+	// ゴミ箱に入っている場合のみ設定
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// 更新のたびに加算される（ETag と同じ値）
+	Version int `json:"version"`
+
+	// 視聴履歴から算出（視聴回数と、2回目以降の視聴回数）
+	WatchCount   int `json:"watch_count"`
+	RewatchCount int `json:"rewatch_count"`
+
+	Tags     []string          `json:"tags,omitempty"`
+	Progress *ProgressResponse `json:"progress,omitempty"`
+	// 作品詳細でのみ設定
+	Credits   []*CreditResponse       `json:"credits,omitempty"`
+	Platforms []*AvailabilityResponse `json:"platforms,omitempty"`
 }
 
 // フィルターパラメータ
@@ -47,12 +84,45 @@ type MovieFilter struct {
 	Genre     string `query:"genre"`
 	Status    string `query:"status"`
 	MediaType string `query:"media_type"`
+	// ?tag=a&tag=b で複数指定。tag_mode=and（既定）は全て、or はいずれかのタグを持つ作品
+	Tags    []string `query:"tag"`
+	TagMode string   `query:"tag_mode" validate:"omitempty,oneof=and or"`
+	// 配信サービスの識別子（netflix など）。現在そのサービスで配信中の作品に絞り込む
+	Platform string `query:"platform"`
+
+	// 範囲指定（いずれも境界を含む。watched_before のみ指定日時を含まない）
+	RatingMin int `query:"rating_min" validate:"omitempty,min=1,max=5"`
+	RatingMax int `query:"rating_max" validate:"omitempty,min=1,max=5"`
+	YearFrom  int `query:"year_from" validate:"omitempty,min=1"`
+	YearTo    int `query:"year_to" validate:"omitempty,min=1"`
+	// 2024-01-31 形式の日付、または RFC3339 形式の日時
+	WatchedAfter  string `query:"watched_after"`
+	WatchedBefore string `query:"watched_before"`
+	// true / false でレビューの有無、評価の有無（unrated=true は未評価のみ）で絞り込む
+	HasReview string `query:"has_review" validate:"omitempty,oneof=true false"`
+	Unrated   string `query:"unrated" validate:"omitempty,oneof=true false"`
+
+	// フィルタ式（例: genre:SF rating>=4 year:2010..2020 -media_type:anime）
+	Filter string `query:"filter" validate:"max=500"`
+
+	// キーワード検索（タイトル・概要・レビューの部分一致。スペース区切りで AND 検索）
+	Query string `query:"q" validate:"max=200"`
+
+	// 並び順（例: -rating,release_year,title。先頭の - は降順、省略時は -created_at、q 指定時は関連度順）
+	Sort string `query:"sort"`
+
+	// ページネーション。cursor には前のページの next_cursor を指定する
+	Limit  int    `query:"limit" validate:"omitempty,min=1,max=100"`
+	Cursor string `query:"cursor"`
 }
 
 // レスポンスラッパー
 type MoviesResponse struct {
-	Data  []*MovieResponse `json:"data"`
-	Count int              `json:"count"`
+	Data []*MovieResponse `json:"data"`
+	// 条件に一致する全件数（ページネーション時もページ内の件数ではない）
+	Count      int    `json:"count"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	HasMore    bool   `json:"has_more"`
 }
 
 type MovieDetailResponse struct {
@@ -66,3 +136,9 @@ type MessageResponse struct {
 type ErrorResponse struct {
 	Error string `json:"error"`
 }
+
+// エクスポートのクエリパラメータ（絞り込み・並び順は一覧と同じ）
+type ExportRequest struct {
+	MovieFilter
+	Format string `query:"format" validate:"required,oneof=csv json ndjson letterboxd"`
+}
@@ -0,0 +1,23 @@
+package dto
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// JSON Merge Patch（RFC 7396）の1フィールド
+// キーが省略された場合は Set が false、null が指定された場合は Set・Null が true になる
+type PatchField[T any] struct {
+	Set   bool
+	Null  bool
+	Value T
+}
+
+func (f *PatchField[T]) UnmarshalJSON(b []byte) error {
+	f.Set = true
+	if bytes.Equal(bytes.TrimSpace(b), []byte("null")) {
+		f.Null = true
+		return nil
+	}
+	return json.Unmarshal(b, &f.Value)
+}
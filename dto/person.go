@@ -0,0 +1,85 @@
+package dto
+
+import "time"
+
+// 人物作成・更新リクエスト
+type PersonRequest struct {
+	Name string `json:"name" validate:"required,max=200"`
+}
+
+// 人物検索パラメータ
+type PersonFilter struct {
+	// 名前の部分一致（大文字・小文字を区別しない）
+	Query string `query:"q"`
+}
+
+// 人物レスポンス
+type PersonResponse struct {
+	ID         int       `json:"id"`
+	Name       string    `json:"name"`
+	MovieCount int       `json:"movie_count"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type PeopleResponse struct {
+	Data []*PersonResponse `json:"data"`
+}
+
+type PersonDetailResponse struct {
+	Data *PersonResponse `json:"data"`
+}
+
+// クレジット追加リクエスト（person_id か person_name のどちらかを指定。未登録の名前は人物を作成）
+type CreditRequest struct {
+	PersonID   int    `json:"person_id" validate:"omitempty,min=1"`
+	PersonName string `json:"person_name" validate:"max=200"`
+	Role       string `json:"role" validate:"required,oneof=director actor studio"`
+	Character  string `json:"character" validate:"max=200"`
+}
+
+// クレジットレスポンス
+type CreditResponse struct {
+	ID         int    `json:"id"`
+	PersonID   int    `json:"person_id"`
+	PersonName string `json:"person_name,omitempty"`
+	Role       string `json:"role"`
+	Character  string `json:"character,omitempty"`
+}
+
+type CreditsResponse struct {
+	Data []*CreditResponse `json:"data"`
+}
+
+type CreditDetailResponse struct {
+	Data *CreditResponse `json:"data"`
+}
+
+// 出演・担当作品（フィルモグラフィー）
+type FilmographyEntryResponse struct {
+	CreditID  int            `json:"credit_id"`
+	Role      string         `json:"role"`
+	Character string         `json:"character,omitempty"`
+	Movie     *MovieResponse `json:"movie"`
+}
+
+type FilmographyResponse struct {
+	Data  []*FilmographyEntryResponse `json:"data"`
+	Count int                         `json:"count"`
+}
+
+// 人物別の視聴統計パラメータ
+type PersonStatsFilter struct {
+	Role  string `query:"role" validate:"omitempty,oneof=director actor studio"`
+	Limit int    `query:"limit" validate:"omitempty,min=1,max=100"`
+}
+
+// 人物別の視聴統計
+type PersonStatResponse struct {
+	PersonID int    `json:"person_id"`
+	Name     string `json:"name"`
+	// 視聴リスト内の担当作品数と、そのうち視聴記録のある作品数
+	TitleCount   int `json:"title_count"`
+	WatchedCount int `json:"watched_count"`
+	// 担当作品の視聴回数の合計（再視聴を含む）
+	WatchCount int `json:"watch_count"`
+}
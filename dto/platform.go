@@ -0,0 +1,67 @@
+package dto
+
+import "time"
+
+// 配信サービス作成リクエスト
+type PlatformRequest struct {
+	Slug string `json:"slug" validate:"required,max=50"`
+	Name string `json:"name" validate:"required,max=100"`
+}
+
+// 配信サービスレスポンス
+type PlatformResponse struct {
+	ID   int    `json:"id"`
+	Slug string `json:"slug"`
+	Name string `json:"name"`
+}
+
+type PlatformsResponse struct {
+	Data []*PlatformResponse `json:"data"`
+}
+
+type PlatformDetailResponse struct {
+	Data *PlatformResponse `json:"data"`
+}
+
+// 配信期間の登録・更新リクエスト（省略した日付は未設定として扱う）
+type AvailabilityRequest struct {
+	AvailableFrom  *time.Time `json:"available_from"`
+	AvailableUntil *time.Time `json:"available_until"`
+}
+
+// 作品の配信状況レスポンス
+type AvailabilityResponse struct {
+	Platform       string     `json:"platform"`
+	PlatformName   string     `json:"platform_name,omitempty"`
+	AvailableFrom  *time.Time `json:"available_from,omitempty"`
+	AvailableUntil *time.Time `json:"available_until,omitempty"`
+	// 現在配信中かどうか
+	Available bool `json:"available"`
+}
+
+type AvailabilitiesResponse struct {
+	Data []*AvailabilityResponse `json:"data"`
+}
+
+type AvailabilityDetailResponse struct {
+	Data *AvailabilityResponse `json:"data"`
+}
+
+// 配信終了予定の作品の絞り込みパラメータ
+type LeavingFilter struct {
+	// 何日以内に配信終了するか（省略時は7日）
+	Days   int    `query:"days" validate:"omitempty,min=1,max=365"`
+	Status string `query:"status" validate:"omitempty,oneof=want_to_watch watching completed dropped"`
+}
+
+// 配信終了予定の作品
+type LeavingMovieResponse struct {
+	AvailableUntil time.Time      `json:"available_until"`
+	DaysLeft       int            `json:"days_left"`
+	Movie          *MovieResponse `json:"movie"`
+}
+
+type LeavingMoviesResponse struct {
+	Data  []*LeavingMovieResponse `json:"data"`
+	Count int                     `json:"count"`
+}
@@ -0,0 +1,24 @@
+package dto
+
+import "time"
+
+// タグ作成・更新リクエスト
+type TagRequest struct {
+	Name string `json:"name" validate:"required,max=50,excludesall=0x2C"`
+}
+
+// タグレスポンス
+type TagResponse struct {
+	ID         int       `json:"id"`
+	Name       string    `json:"name"`
+	MovieCount int       `json:"movie_count"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type TagsResponse struct {
+	Data []*TagResponse `json:"data"`
+}
+
+type TagDetailResponse struct {
+	Data *TagResponse `json:"data"`
+}
@@ -0,0 +1,46 @@
+package dto
+
+import "time"
+
+// ユーザー登録リクエスト
+type RegisterRequest struct {
+	Email    string `json:"email" validate:"required,email"`
+	Password string `json:"password" validate:"required,min=8,max=72"`
+	Name     string `json:"name"`
+}
+
+// ログインリクエスト
+type LoginRequest struct {
+	Email    string `json:"email" validate:"required,email"`
+	Password string `json:"password" validate:"required"`
+}
+
+// ユーザーレスポンス
+type UserResponse struct {
+	ID        int       `json:"id"`
+	Email     string    `json:"email"`
+	Name      string    `json:"name,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type UserDetailResponse struct {
+	Data *UserResponse `json:"data"`
+}
+
+// トークン再発行・ログアウトリクエスト
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token" validate:"required"`
+}
+
+// トークンレスポンス
+type TokenResponse struct {
+	AccessToken  string        `json:"access_token"`
+	RefreshToken string        `json:"refresh_token"`
+	TokenType    string        `json:"token_type"`
+	ExpiresIn    int           `json:"expires_in"`
+	User         *UserResponse `json:"user,omitempty"`
+}
+
+type TokenDetailResponse struct {
+	Data *TokenResponse `json:"data"`
+}
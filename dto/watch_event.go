@@ -0,0 +1,31 @@
+package dto
+
+import "time"
+
+// 視聴記録作成リクエスト
+type CreateWatchEventRequest struct {
+	// 省略時は現在日時
+	WatchedAt *time.Time `json:"watched_at"`
+	Rating    int        `json:"rating" validate:"omitempty,min=1,max=5"`
+	Note      string     `json:"note"`
+	Location  string     `json:"location" validate:"max=100"`
+}
+
+// 視聴記録レスポンス
+type WatchEventResponse struct {
+	ID        int       `json:"id"`
+	WatchedAt time.Time `json:"watched_at"`
+	Rating    int       `json:"rating,omitempty"`
+	Note      string    `json:"note,omitempty"`
+	Location  string    `json:"location,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type WatchEventsResponse struct {
+	Data  []*WatchEventResponse `json:"data"`
+	Count int                   `json:"count"`
+}
+
+type WatchEventDetailResponse struct {
+	Data *WatchEventResponse `json:"data"`
+}
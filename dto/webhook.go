@@ -0,0 +1,69 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Webhook 作成・更新リクエスト
+type WebhookRequest struct {
+	URL string `json:"url" validate:"required,http_url,max=2000"`
+	// 作成時に省略した場合は自動で生成する。更新時に省略した場合は変更しない
+	Secret string   `json:"secret" validate:"omitempty,min=16,max=200"`
+	Events []string `json:"events" validate:"required,min=1,dive,oneof=movie.created movie.status_changed movie.rated movie.deleted"`
+	// 省略時は有効
+	Active *bool `json:"active"`
+}
+
+// Webhook レスポンス
+type WebhookResponse struct {
+	ID     int      `json:"id"`
+	URL    string   `json:"url"`
+	Events []string `json:"events"`
+	Active bool     `json:"active"`
+	// 作成時のみ返す
+	Secret    string    `json:"secret,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type WebhooksResponse struct {
+	Data []*WebhookResponse `json:"data"`
+}
+
+type WebhookDetailResponse struct {
+	Data *WebhookResponse `json:"data"`
+}
+
+// 配信履歴のクエリパラメータ
+type WebhookDeliveryFilter struct {
+	Status string `query:"status" validate:"omitempty,oneof=pending succeeded failed"`
+	Limit  int    `query:"limit" validate:"omitempty,min=1,max=100"`
+}
+
+// 配信履歴レスポンス
+type WebhookDeliveryResponse struct {
+	ID             int        `json:"id"`
+	WebhookID      int        `json:"webhook_id"`
+	Event          string     `json:"event"`
+	MovieID        int        `json:"movie_id"`
+	Status         string     `json:"status"`
+	Attempts       int        `json:"attempts"`
+	NextAttemptAt  *time.Time `json:"next_attempt_at,omitempty"`
+	ResponseStatus *int       `json:"response_status,omitempty"`
+	LastError      string     `json:"last_error,omitempty"`
+	DeliveredAt    *time.Time `json:"delivered_at,omitempty"`
+	RedeliveryOf   *int       `json:"redelivery_of,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	// 詳細取得時のみ返す
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+type WebhookDeliveriesResponse struct {
+	Data  []*WebhookDeliveryResponse `json:"data"`
+	Count int                        `json:"count"`
+}
+
+type WebhookDeliveryDetailResponse struct {
+	Data *WebhookDeliveryResponse `json:"data"`
+}
@@ -0,0 +1,182 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"watchlist-app/ent/availability"
+	"watchlist-app/ent/movie"
+	"watchlist-app/ent/platform"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Availability is the model entity for the Availability schema.
+type Availability struct {
+	config `json:"-"`
+	// 作品ID
+	MovieID int `json:"movie_id,omitempty"`
+	// 配信サービスID
+	PlatformID int `json:"platform_id,omitempty"`
+	// 配信開始日（未設定は開始日不明・配信中として扱う）
+	AvailableFrom *time.Time `json:"available_from,omitempty"`
+	// 配信終了日（未設定は終了予定なし）
+	AvailableUntil *time.Time `json:"available_until,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AvailabilityQuery when eager-loading is set.
+	Edges        AvailabilityEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AvailabilityEdges holds the relations/edges for other nodes in the graph.
+type AvailabilityEdges struct {
+	// Movie holds the value of the movie edge.
+	Movie *Movie `json:"movie,omitempty"`
+	// Platform holds the value of the platform edge.
+	Platform *Platform `json:"platform,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// MovieOrErr returns the Movie value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AvailabilityEdges) MovieOrErr() (*Movie, error) {
+	if e.Movie != nil {
+		return e.Movie, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: movie.Label}
+	}
+	return nil, &NotLoadedError{edge: "movie"}
+}
+
+// PlatformOrErr returns the Platform value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AvailabilityEdges) PlatformOrErr() (*Platform, error) {
+	if e.Platform != nil {
+		return e.Platform, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: platform.Label}
+	}
+	return nil, &NotLoadedError{edge: "platform"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Availability) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case availability.FieldMovieID, availability.FieldPlatformID:
+			values[i] = new(sql.NullInt64)
+		case availability.FieldAvailableFrom, availability.FieldAvailableUntil:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Availability fields.
+func (_m *Availability) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case availability.FieldMovieID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field movie_id", values[i])
+			} else if value.Valid {
+				_m.MovieID = int(value.Int64)
+			}
+		case availability.FieldPlatformID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field platform_id", values[i])
+			} else if value.Valid {
+				_m.PlatformID = int(value.Int64)
+			}
+		case availability.FieldAvailableFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field available_from", values[i])
+			} else if value.Valid {
+				_m.AvailableFrom = new(time.Time)
+				*_m.AvailableFrom = value.Time
+			}
+		case availability.FieldAvailableUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field available_until", values[i])
+			} else if value.Valid {
+				_m.AvailableUntil = new(time.Time)
+				*_m.AvailableUntil = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Availability.
+// This includes values selected through modifiers, order, etc.
+func (_m *Availability) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryMovie queries the "movie" edge of the Availability entity.
+func (_m *Availability) QueryMovie() *MovieQuery {
+	return NewAvailabilityClient(_m.config).QueryMovie(_m)
+}
+
+// QueryPlatform queries the "platform" edge of the Availability entity.
+func (_m *Availability) QueryPlatform() *PlatformQuery {
+	return NewAvailabilityClient(_m.config).QueryPlatform(_m)
+}
+
+// Update returns a builder for updating this Availability.
+// Note that you need to call Availability.Unwrap() before calling this method if this Availability
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Availability) Update() *AvailabilityUpdateOne {
+	return NewAvailabilityClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Availability entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Availability) Unwrap() *Availability {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Availability is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Availability) String() string {
+	var builder strings.Builder
+	builder.WriteString("Availability(")
+	builder.WriteString("movie_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MovieID))
+	builder.WriteString(", ")
+	builder.WriteString("platform_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PlatformID))
+	builder.WriteString(", ")
+	if v := _m.AvailableFrom; v != nil {
+		builder.WriteString("available_from=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.AvailableUntil; v != nil {
+		builder.WriteString("available_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Availabilities is a parsable slice of Availability.
+type Availabilities []*Availability
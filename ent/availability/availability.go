@@ -0,0 +1,114 @@
+// Code generated by ent, DO NOT EDIT.
+
+package availability
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the availability type in the database.
+	Label = "availability"
+	// FieldMovieID holds the string denoting the movie_id field in the database.
+	FieldMovieID = "movie_id"
+	// FieldPlatformID holds the string denoting the platform_id field in the database.
+	FieldPlatformID = "platform_id"
+	// FieldAvailableFrom holds the string denoting the available_from field in the database.
+	FieldAvailableFrom = "available_from"
+	// FieldAvailableUntil holds the string denoting the available_until field in the database.
+	FieldAvailableUntil = "available_until"
+	// EdgeMovie holds the string denoting the movie edge name in mutations.
+	EdgeMovie = "movie"
+	// EdgePlatform holds the string denoting the platform edge name in mutations.
+	EdgePlatform = "platform"
+	// MovieFieldID holds the string denoting the ID field of the Movie.
+	MovieFieldID = "id"
+	// PlatformFieldID holds the string denoting the ID field of the Platform.
+	PlatformFieldID = "id"
+	// Table holds the table name of the availability in the database.
+	Table = "availabilities"
+	// MovieTable is the table that holds the movie relation/edge.
+	MovieTable = "availabilities"
+	// MovieInverseTable is the table name for the Movie entity.
+	// It exists in this package in order to avoid circular dependency with the "movie" package.
+	MovieInverseTable = "movies"
+	// MovieColumn is the table column denoting the movie relation/edge.
+	MovieColumn = "movie_id"
+	// PlatformTable is the table that holds the platform relation/edge.
+	PlatformTable = "availabilities"
+	// PlatformInverseTable is the table name for the Platform entity.
+	// It exists in this package in order to avoid circular dependency with the "platform" package.
+	PlatformInverseTable = "platforms"
+	// PlatformColumn is the table column denoting the platform relation/edge.
+	PlatformColumn = "platform_id"
+)
+
+// Columns holds all SQL columns for availability fields.
+var Columns = []string{
+	FieldMovieID,
+	FieldPlatformID,
+	FieldAvailableFrom,
+	FieldAvailableUntil,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// OrderOption defines the ordering options for the Availability queries.
+type OrderOption func(*sql.Selector)
+
+// ByMovieID orders the results by the movie_id field.
+func ByMovieID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMovieID, opts...).ToFunc()
+}
+
+// ByPlatformID orders the results by the platform_id field.
+func ByPlatformID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlatformID, opts...).ToFunc()
+}
+
+// ByAvailableFrom orders the results by the available_from field.
+func ByAvailableFrom(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAvailableFrom, opts...).ToFunc()
+}
+
+// ByAvailableUntil orders the results by the available_until field.
+func ByAvailableUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAvailableUntil, opts...).ToFunc()
+}
+
+// ByMovieField orders the results by movie field.
+func ByMovieField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMovieStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByPlatformField orders the results by platform field.
+func ByPlatformField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPlatformStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newMovieStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, MovieColumn),
+		sqlgraph.To(MovieInverseTable, MovieFieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, MovieTable, MovieColumn),
+	)
+}
+func newPlatformStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, PlatformColumn),
+		sqlgraph.To(PlatformInverseTable, PlatformFieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, PlatformTable, PlatformColumn),
+	)
+}
@@ -0,0 +1,232 @@
+// Code generated by ent, DO NOT EDIT.
+
+package availability
+
+import (
+	"time"
+	"watchlist-app/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// MovieID applies equality check predicate on the "movie_id" field. It's identical to MovieIDEQ.
+func MovieID(v int) predicate.Availability {
+	return predicate.Availability(sql.FieldEQ(FieldMovieID, v))
+}
+
+// PlatformID applies equality check predicate on the "platform_id" field. It's identical to PlatformIDEQ.
+func PlatformID(v int) predicate.Availability {
+	return predicate.Availability(sql.FieldEQ(FieldPlatformID, v))
+}
+
+// AvailableFrom applies equality check predicate on the "available_from" field. It's identical to AvailableFromEQ.
+func AvailableFrom(v time.Time) predicate.Availability {
+	return predicate.Availability(sql.FieldEQ(FieldAvailableFrom, v))
+}
+
+// AvailableUntil applies equality check predicate on the "available_until" field. It's identical to AvailableUntilEQ.
+func AvailableUntil(v time.Time) predicate.Availability {
+	return predicate.Availability(sql.FieldEQ(FieldAvailableUntil, v))
+}
+
+// MovieIDEQ applies the EQ predicate on the "movie_id" field.
+func MovieIDEQ(v int) predicate.Availability {
+	return predicate.Availability(sql.FieldEQ(FieldMovieID, v))
+}
+
+// MovieIDNEQ applies the NEQ predicate on the "movie_id" field.
+func MovieIDNEQ(v int) predicate.Availability {
+	return predicate.Availability(sql.FieldNEQ(FieldMovieID, v))
+}
+
+// MovieIDIn applies the In predicate on the "movie_id" field.
+func MovieIDIn(vs ...int) predicate.Availability {
+	return predicate.Availability(sql.FieldIn(FieldMovieID, vs...))
+}
+
+// MovieIDNotIn applies the NotIn predicate on the "movie_id" field.
+func MovieIDNotIn(vs ...int) predicate.Availability {
+	return predicate.Availability(sql.FieldNotIn(FieldMovieID, vs...))
+}
+
+// PlatformIDEQ applies the EQ predicate on the "platform_id" field.
+func PlatformIDEQ(v int) predicate.Availability {
+	return predicate.Availability(sql.FieldEQ(FieldPlatformID, v))
+}
+
+// PlatformIDNEQ applies the NEQ predicate on the "platform_id" field.
+func PlatformIDNEQ(v int) predicate.Availability {
+	return predicate.Availability(sql.FieldNEQ(FieldPlatformID, v))
+}
+
+// PlatformIDIn applies the In predicate on the "platform_id" field.
+func PlatformIDIn(vs ...int) predicate.Availability {
+	return predicate.Availability(sql.FieldIn(FieldPlatformID, vs...))
+}
+
+// PlatformIDNotIn applies the NotIn predicate on the "platform_id" field.
+func PlatformIDNotIn(vs ...int) predicate.Availability {
+	return predicate.Availability(sql.FieldNotIn(FieldPlatformID, vs...))
+}
+
+// AvailableFromEQ applies the EQ predicate on the "available_from" field.
+func AvailableFromEQ(v time.Time) predicate.Availability {
+	return predicate.Availability(sql.FieldEQ(FieldAvailableFrom, v))
+}
+
+// AvailableFromNEQ applies the NEQ predicate on the "available_from" field.
+func AvailableFromNEQ(v time.Time) predicate.Availability {
+	return predicate.Availability(sql.FieldNEQ(FieldAvailableFrom, v))
+}
+
+// AvailableFromIn applies the In predicate on the "available_from" field.
+func AvailableFromIn(vs ...time.Time) predicate.Availability {
+	return predicate.Availability(sql.FieldIn(FieldAvailableFrom, vs...))
+}
+
+// AvailableFromNotIn applies the NotIn predicate on the "available_from" field.
+func AvailableFromNotIn(vs ...time.Time) predicate.Availability {
+	return predicate.Availability(sql.FieldNotIn(FieldAvailableFrom, vs...))
+}
+
+// AvailableFromGT applies the GT predicate on the "available_from" field.
+func AvailableFromGT(v time.Time) predicate.Availability {
+	return predicate.Availability(sql.FieldGT(FieldAvailableFrom, v))
+}
+
+// AvailableFromGTE applies the GTE predicate on the "available_from" field.
+func AvailableFromGTE(v time.Time) predicate.Availability {
+	return predicate.Availability(sql.FieldGTE(FieldAvailableFrom, v))
+}
+
+// AvailableFromLT applies the LT predicate on the "available_from" field.
+func AvailableFromLT(v time.Time) predicate.Availability {
+	return predicate.Availability(sql.FieldLT(FieldAvailableFrom, v))
+}
+
+// AvailableFromLTE applies the LTE predicate on the "available_from" field.
+func AvailableFromLTE(v time.Time) predicate.Availability {
+	return predicate.Availability(sql.FieldLTE(FieldAvailableFrom, v))
+}
+
+// AvailableFromIsNil applies the IsNil predicate on the "available_from" field.
+func AvailableFromIsNil() predicate.Availability {
+	return predicate.Availability(sql.FieldIsNull(FieldAvailableFrom))
+}
+
+// AvailableFromNotNil applies the NotNil predicate on the "available_from" field.
+func AvailableFromNotNil() predicate.Availability {
+	return predicate.Availability(sql.FieldNotNull(FieldAvailableFrom))
+}
+
+// AvailableUntilEQ applies the EQ predicate on the "available_until" field.
+func AvailableUntilEQ(v time.Time) predicate.Availability {
+	return predicate.Availability(sql.FieldEQ(FieldAvailableUntil, v))
+}
+
+// AvailableUntilNEQ applies the NEQ predicate on the "available_until" field.
+func AvailableUntilNEQ(v time.Time) predicate.Availability {
+	return predicate.Availability(sql.FieldNEQ(FieldAvailableUntil, v))
+}
+
+// AvailableUntilIn applies the In predicate on the "available_until" field.
+func AvailableUntilIn(vs ...time.Time) predicate.Availability {
+	return predicate.Availability(sql.FieldIn(FieldAvailableUntil, vs...))
+}
+
+// AvailableUntilNotIn applies the NotIn predicate on the "available_until" field.
+func AvailableUntilNotIn(vs ...time.Time) predicate.Availability {
+	return predicate.Availability(sql.FieldNotIn(FieldAvailableUntil, vs...))
+}
+
+// AvailableUntilGT applies the GT predicate on the "available_until" field.
+func AvailableUntilGT(v time.Time) predicate.Availability {
+	return predicate.Availability(sql.FieldGT(FieldAvailableUntil, v))
+}
+
+// AvailableUntilGTE applies the GTE predicate on the "available_until" field.
+func AvailableUntilGTE(v time.Time) predicate.Availability {
+	return predicate.Availability(sql.FieldGTE(FieldAvailableUntil, v))
+}
+
+// AvailableUntilLT applies the LT predicate on the "available_until" field.
+func AvailableUntilLT(v time.Time) predicate.Availability {
+	return predicate.Availability(sql.FieldLT(FieldAvailableUntil, v))
+}
+
+// AvailableUntilLTE applies the LTE predicate on the "available_until" field.
+func AvailableUntilLTE(v time.Time) predicate.Availability {
+	return predicate.Availability(sql.FieldLTE(FieldAvailableUntil, v))
+}
+
+// AvailableUntilIsNil applies the IsNil predicate on the "available_until" field.
+func AvailableUntilIsNil() predicate.Availability {
+	return predicate.Availability(sql.FieldIsNull(FieldAvailableUntil))
+}
+
+// AvailableUntilNotNil applies the NotNil predicate on the "available_until" field.
+func AvailableUntilNotNil() predicate.Availability {
+	return predicate.Availability(sql.FieldNotNull(FieldAvailableUntil))
+}
+
+// HasMovie applies the HasEdge predicate on the "movie" edge.
+func HasMovie() predicate.Availability {
+	return predicate.Availability(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, MovieColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, MovieTable, MovieColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMovieWith applies the HasEdge predicate on the "movie" edge with a given conditions (other predicates).
+func HasMovieWith(preds ...predicate.Movie) predicate.Availability {
+	return predicate.Availability(func(s *sql.Selector) {
+		step := newMovieStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPlatform applies the HasEdge predicate on the "platform" edge.
+func HasPlatform() predicate.Availability {
+	return predicate.Availability(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, PlatformColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, PlatformTable, PlatformColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPlatformWith applies the HasEdge predicate on the "platform" edge with a given conditions (other predicates).
+func HasPlatformWith(preds ...predicate.Platform) predicate.Availability {
+	return predicate.Availability(func(s *sql.Selector) {
+		step := newPlatformStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Availability) predicate.Availability {
+	return predicate.Availability(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Availability) predicate.Availability {
+	return predicate.Availability(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Availability) predicate.Availability {
+	return predicate.Availability(sql.NotPredicates(p))
+}
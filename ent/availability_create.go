@@ -0,0 +1,268 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"watchlist-app/ent/availability"
+	"watchlist-app/ent/movie"
+	"watchlist-app/ent/platform"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AvailabilityCreate is the builder for creating a Availability entity.
+type AvailabilityCreate struct {
+	config
+	mutation *AvailabilityMutation
+	hooks    []Hook
+}
+
+// SetMovieID sets the "movie_id" field.
+func (_c *AvailabilityCreate) SetMovieID(v int) *AvailabilityCreate {
+	_c.mutation.SetMovieID(v)
+	return _c
+}
+
+// SetPlatformID sets the "platform_id" field.
+func (_c *AvailabilityCreate) SetPlatformID(v int) *AvailabilityCreate {
+	_c.mutation.SetPlatformID(v)
+	return _c
+}
+
+// SetAvailableFrom sets the "available_from" field.
+func (_c *AvailabilityCreate) SetAvailableFrom(v time.Time) *AvailabilityCreate {
+	_c.mutation.SetAvailableFrom(v)
+	return _c
+}
+
+// SetNillableAvailableFrom sets the "available_from" field if the given value is not nil.
+func (_c *AvailabilityCreate) SetNillableAvailableFrom(v *time.Time) *AvailabilityCreate {
+	if v != nil {
+		_c.SetAvailableFrom(*v)
+	}
+	return _c
+}
+
+// SetAvailableUntil sets the "available_until" field.
+func (_c *AvailabilityCreate) SetAvailableUntil(v time.Time) *AvailabilityCreate {
+	_c.mutation.SetAvailableUntil(v)
+	return _c
+}
+
+// SetNillableAvailableUntil sets the "available_until" field if the given value is not nil.
+func (_c *AvailabilityCreate) SetNillableAvailableUntil(v *time.Time) *AvailabilityCreate {
+	if v != nil {
+		_c.SetAvailableUntil(*v)
+	}
+	return _c
+}
+
+// SetMovie sets the "movie" edge to the Movie entity.
+func (_c *AvailabilityCreate) SetMovie(v *Movie) *AvailabilityCreate {
+	return _c.SetMovieID(v.ID)
+}
+
+// SetPlatform sets the "platform" edge to the Platform entity.
+func (_c *AvailabilityCreate) SetPlatform(v *Platform) *AvailabilityCreate {
+	return _c.SetPlatformID(v.ID)
+}
+
+// Mutation returns the AvailabilityMutation object of the builder.
+func (_c *AvailabilityCreate) Mutation() *AvailabilityMutation {
+	return _c.mutation
+}
+
+// Save creates the Availability in the database.
+func (_c *AvailabilityCreate) Save(ctx context.Context) (*Availability, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AvailabilityCreate) SaveX(ctx context.Context) *Availability {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AvailabilityCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AvailabilityCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AvailabilityCreate) check() error {
+	if _, ok := _c.mutation.MovieID(); !ok {
+		return &ValidationError{Name: "movie_id", err: errors.New(`ent: missing required field "Availability.movie_id"`)}
+	}
+	if _, ok := _c.mutation.PlatformID(); !ok {
+		return &ValidationError{Name: "platform_id", err: errors.New(`ent: missing required field "Availability.platform_id"`)}
+	}
+	if len(_c.mutation.MovieIDs()) == 0 {
+		return &ValidationError{Name: "movie", err: errors.New(`ent: missing required edge "Availability.movie"`)}
+	}
+	if len(_c.mutation.PlatformIDs()) == 0 {
+		return &ValidationError{Name: "platform", err: errors.New(`ent: missing required edge "Availability.platform"`)}
+	}
+	return nil
+}
+
+func (_c *AvailabilityCreate) sqlSave(ctx context.Context) (*Availability, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
+
+func (_c *AvailabilityCreate) createSpec() (*Availability, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Availability{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(availability.Table, nil)
+	)
+	if value, ok := _c.mutation.AvailableFrom(); ok {
+		_spec.SetField(availability.FieldAvailableFrom, field.TypeTime, value)
+		_node.AvailableFrom = &value
+	}
+	if value, ok := _c.mutation.AvailableUntil(); ok {
+		_spec.SetField(availability.FieldAvailableUntil, field.TypeTime, value)
+		_node.AvailableUntil = &value
+	}
+	if nodes := _c.mutation.MovieIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   availability.MovieTable,
+			Columns: []string{availability.MovieColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(movie.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MovieID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PlatformIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   availability.PlatformTable,
+			Columns: []string{availability.PlatformColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(platform.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PlatformID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AvailabilityCreateBulk is the builder for creating many Availability entities in bulk.
+type AvailabilityCreateBulk struct {
+	config
+	err      error
+	builders []*AvailabilityCreate
+}
+
+// Save creates the Availability entities in the database.
+func (_c *AvailabilityCreateBulk) Save(ctx context.Context) ([]*Availability, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Availability, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AvailabilityMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AvailabilityCreateBulk) SaveX(ctx context.Context) []*Availability {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AvailabilityCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AvailabilityCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
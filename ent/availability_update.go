@@ -0,0 +1,528 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"watchlist-app/ent/availability"
+	"watchlist-app/ent/movie"
+	"watchlist-app/ent/platform"
+	"watchlist-app/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AvailabilityUpdate is the builder for updating Availability entities.
+type AvailabilityUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AvailabilityMutation
+}
+
+// Where appends a list predicates to the AvailabilityUpdate builder.
+func (_u *AvailabilityUpdate) Where(ps ...predicate.Availability) *AvailabilityUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetMovieID sets the "movie_id" field.
+func (_u *AvailabilityUpdate) SetMovieID(v int) *AvailabilityUpdate {
+	_u.mutation.SetMovieID(v)
+	return _u
+}
+
+// SetNillableMovieID sets the "movie_id" field if the given value is not nil.
+func (_u *AvailabilityUpdate) SetNillableMovieID(v *int) *AvailabilityUpdate {
+	if v != nil {
+		_u.SetMovieID(*v)
+	}
+	return _u
+}
+
+// SetPlatformID sets the "platform_id" field.
+func (_u *AvailabilityUpdate) SetPlatformID(v int) *AvailabilityUpdate {
+	_u.mutation.SetPlatformID(v)
+	return _u
+}
+
+// SetNillablePlatformID sets the "platform_id" field if the given value is not nil.
+func (_u *AvailabilityUpdate) SetNillablePlatformID(v *int) *AvailabilityUpdate {
+	if v != nil {
+		_u.SetPlatformID(*v)
+	}
+	return _u
+}
+
+// SetAvailableFrom sets the "available_from" field.
+func (_u *AvailabilityUpdate) SetAvailableFrom(v time.Time) *AvailabilityUpdate {
+	_u.mutation.SetAvailableFrom(v)
+	return _u
+}
+
+// SetNillableAvailableFrom sets the "available_from" field if the given value is not nil.
+func (_u *AvailabilityUpdate) SetNillableAvailableFrom(v *time.Time) *AvailabilityUpdate {
+	if v != nil {
+		_u.SetAvailableFrom(*v)
+	}
+	return _u
+}
+
+// ClearAvailableFrom clears the value of the "available_from" field.
+func (_u *AvailabilityUpdate) ClearAvailableFrom() *AvailabilityUpdate {
+	_u.mutation.ClearAvailableFrom()
+	return _u
+}
+
+// SetAvailableUntil sets the "available_until" field.
+func (_u *AvailabilityUpdate) SetAvailableUntil(v time.Time) *AvailabilityUpdate {
+	_u.mutation.SetAvailableUntil(v)
+	return _u
+}
+
+// SetNillableAvailableUntil sets the "available_until" field if the given value is not nil.
+func (_u *AvailabilityUpdate) SetNillableAvailableUntil(v *time.Time) *AvailabilityUpdate {
+	if v != nil {
+		_u.SetAvailableUntil(*v)
+	}
+	return _u
+}
+
+// ClearAvailableUntil clears the value of the "available_until" field.
+func (_u *AvailabilityUpdate) ClearAvailableUntil() *AvailabilityUpdate {
+	_u.mutation.ClearAvailableUntil()
+	return _u
+}
+
+// SetMovie sets the "movie" edge to the Movie entity.
+func (_u *AvailabilityUpdate) SetMovie(v *Movie) *AvailabilityUpdate {
+	return _u.SetMovieID(v.ID)
+}
+
+// SetPlatform sets the "platform" edge to the Platform entity.
+func (_u *AvailabilityUpdate) SetPlatform(v *Platform) *AvailabilityUpdate {
+	return _u.SetPlatformID(v.ID)
+}
+
+// Mutation returns the AvailabilityMutation object of the builder.
+func (_u *AvailabilityUpdate) Mutation() *AvailabilityMutation {
+	return _u.mutation
+}
+
+// ClearMovie clears the "movie" edge to the Movie entity.
+func (_u *AvailabilityUpdate) ClearMovie() *AvailabilityUpdate {
+	_u.mutation.ClearMovie()
+	return _u
+}
+
+// ClearPlatform clears the "platform" edge to the Platform entity.
+func (_u *AvailabilityUpdate) ClearPlatform() *AvailabilityUpdate {
+	_u.mutation.ClearPlatform()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AvailabilityUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AvailabilityUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AvailabilityUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AvailabilityUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AvailabilityUpdate) check() error {
+	if _u.mutation.MovieCleared() && len(_u.mutation.MovieIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Availability.movie"`)
+	}
+	if _u.mutation.PlatformCleared() && len(_u.mutation.PlatformIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Availability.platform"`)
+	}
+	return nil
+}
+
+func (_u *AvailabilityUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(availability.Table, availability.Columns, sqlgraph.NewFieldSpec(availability.FieldMovieID, field.TypeInt), sqlgraph.NewFieldSpec(availability.FieldPlatformID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.AvailableFrom(); ok {
+		_spec.SetField(availability.FieldAvailableFrom, field.TypeTime, value)
+	}
+	if _u.mutation.AvailableFromCleared() {
+		_spec.ClearField(availability.FieldAvailableFrom, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AvailableUntil(); ok {
+		_spec.SetField(availability.FieldAvailableUntil, field.TypeTime, value)
+	}
+	if _u.mutation.AvailableUntilCleared() {
+		_spec.ClearField(availability.FieldAvailableUntil, field.TypeTime)
+	}
+	if _u.mutation.MovieCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   availability.MovieTable,
+			Columns: []string{availability.MovieColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(movie.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MovieIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   availability.MovieTable,
+			Columns: []string{availability.MovieColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(movie.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PlatformCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   availability.PlatformTable,
+			Columns: []string{availability.PlatformColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(platform.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PlatformIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   availability.PlatformTable,
+			Columns: []string{availability.PlatformColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(platform.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{availability.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AvailabilityUpdateOne is the builder for updating a single Availability entity.
+type AvailabilityUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AvailabilityMutation
+}
+
+// SetMovieID sets the "movie_id" field.
+func (_u *AvailabilityUpdateOne) SetMovieID(v int) *AvailabilityUpdateOne {
+	_u.mutation.SetMovieID(v)
+	return _u
+}
+
+// SetNillableMovieID sets the "movie_id" field if the given value is not nil.
+func (_u *AvailabilityUpdateOne) SetNillableMovieID(v *int) *AvailabilityUpdateOne {
+	if v != nil {
+		_u.SetMovieID(*v)
+	}
+	return _u
+}
+
+// SetPlatformID sets the "platform_id" field.
+func (_u *AvailabilityUpdateOne) SetPlatformID(v int) *AvailabilityUpdateOne {
+	_u.mutation.SetPlatformID(v)
+	return _u
+}
+
+// SetNillablePlatformID sets the "platform_id" field if the given value is not nil.
+func (_u *AvailabilityUpdateOne) SetNillablePlatformID(v *int) *AvailabilityUpdateOne {
+	if v != nil {
+		_u.SetPlatformID(*v)
+	}
+	return _u
+}
+
+// SetAvailableFrom sets the "available_from" field.
+func (_u *AvailabilityUpdateOne) SetAvailableFrom(v time.Time) *AvailabilityUpdateOne {
+	_u.mutation.SetAvailableFrom(v)
+	return _u
+}
+
+// SetNillableAvailableFrom sets the "available_from" field if the given value is not nil.
+func (_u *AvailabilityUpdateOne) SetNillableAvailableFrom(v *time.Time) *AvailabilityUpdateOne {
+	if v != nil {
+		_u.SetAvailableFrom(*v)
+	}
+	return _u
+}
+
+// ClearAvailableFrom clears the value of the "available_from" field.
+func (_u *AvailabilityUpdateOne) ClearAvailableFrom() *AvailabilityUpdateOne {
+	_u.mutation.ClearAvailableFrom()
+	return _u
+}
+
+// SetAvailableUntil sets the "available_until" field.
+func (_u *AvailabilityUpdateOne) SetAvailableUntil(v time.Time) *AvailabilityUpdateOne {
+	_u.mutation.SetAvailableUntil(v)
+	return _u
+}
+
+// SetNillableAvailableUntil sets the "available_until" field if the given value is not nil.
+func (_u *AvailabilityUpdateOne) SetNillableAvailableUntil(v *time.Time) *AvailabilityUpdateOne {
+	if v != nil {
+		_u.SetAvailableUntil(*v)
+	}
+	return _u
+}
+
+// ClearAvailableUntil clears the value of the "available_until" field.
+func (_u *AvailabilityUpdateOne) ClearAvailableUntil() *AvailabilityUpdateOne {
+	_u.mutation.ClearAvailableUntil()
+	return _u
+}
+
+// SetMovie sets the "movie" edge to the Movie entity.
+func (_u *AvailabilityUpdateOne) SetMovie(v *Movie) *AvailabilityUpdateOne {
+	return _u.SetMovieID(v.ID)
+}
+
+// SetPlatform sets the "platform" edge to the Platform entity.
+func (_u *AvailabilityUpdateOne) SetPlatform(v *Platform) *AvailabilityUpdateOne {
+	return _u.SetPlatformID(v.ID)
+}
+
+// Mutation returns the AvailabilityMutation object of the builder.
+func (_u *AvailabilityUpdateOne) Mutation() *AvailabilityMutation {
+	return _u.mutation
+}
+
+// ClearMovie clears the "movie" edge to the Movie entity.
+func (_u *AvailabilityUpdateOne) ClearMovie() *AvailabilityUpdateOne {
+	_u.mutation.ClearMovie()
+	return _u
+}
+
+// ClearPlatform clears the "platform" edge to the Platform entity.
+func (_u *AvailabilityUpdateOne) ClearPlatform() *AvailabilityUpdateOne {
+	_u.mutation.ClearPlatform()
+	return _u
+}
+
+// Where appends a list predicates to the AvailabilityUpdate builder.
+func (_u *AvailabilityUpdateOne) Where(ps ...predicate.Availability) *AvailabilityUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AvailabilityUpdateOne) Select(field string, fields ...string) *AvailabilityUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Availability entity.
+func (_u *AvailabilityUpdateOne) Save(ctx context.Context) (*Availability, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AvailabilityUpdateOne) SaveX(ctx context.Context) *Availability {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AvailabilityUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AvailabilityUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AvailabilityUpdateOne) check() error {
+	if _u.mutation.MovieCleared() && len(_u.mutation.MovieIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Availability.movie"`)
+	}
+	if _u.mutation.PlatformCleared() && len(_u.mutation.PlatformIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Availability.platform"`)
+	}
+	return nil
+}
+
+func (_u *AvailabilityUpdateOne) sqlSave(ctx context.Context) (_node *Availability, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(availability.Table, availability.Columns, sqlgraph.NewFieldSpec(availability.FieldMovieID, field.TypeInt), sqlgraph.NewFieldSpec(availability.FieldPlatformID, field.TypeInt))
+	if id, ok := _u.mutation.MovieID(); !ok {
+		return nil, &ValidationError{Name: "movie_id", err: errors.New(`ent: missing "Availability.movie_id" for update`)}
+	} else {
+		_spec.Node.CompositeID[0].Value = id
+	}
+	if id, ok := _u.mutation.PlatformID(); !ok {
+		return nil, &ValidationError{Name: "platform_id", err: errors.New(`ent: missing "Availability.platform_id" for update`)}
+	} else {
+		_spec.Node.CompositeID[1].Value = id
+	}
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, len(fields))
+		for i, f := range fields {
+			if !availability.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			_spec.Node.Columns[i] = f
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.AvailableFrom(); ok {
+		_spec.SetField(availability.FieldAvailableFrom, field.TypeTime, value)
+	}
+	if _u.mutation.AvailableFromCleared() {
+		_spec.ClearField(availability.FieldAvailableFrom, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AvailableUntil(); ok {
+		_spec.SetField(availability.FieldAvailableUntil, field.TypeTime, value)
+	}
+	if _u.mutation.AvailableUntilCleared() {
+		_spec.ClearField(availability.FieldAvailableUntil, field.TypeTime)
+	}
+	if _u.mutation.MovieCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   availability.MovieTable,
+			Columns: []string{availability.MovieColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(movie.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MovieIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   availability.MovieTable,
+			Columns: []string{availability.MovieColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(movie.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PlatformCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   availability.PlatformTable,
+			Columns: []string{availability.PlatformColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(platform.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PlatformIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   availability.PlatformTable,
+			Columns: []string{availability.PlatformColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(platform.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Availability{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{availability.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,184 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"watchlist-app/ent/credit"
+	"watchlist-app/ent/movie"
+	"watchlist-app/ent/person"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Credit is the model entity for the Credit schema.
+type Credit struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 人物ID
+	PersonID int `json:"person_id,omitempty"`
+	// 作品ID
+	MovieID int `json:"movie_id,omitempty"`
+	// 役割
+	Role credit.Role `json:"role,omitempty"`
+	// 役名（出演時のみ）
+	Character string `json:"character,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CreditQuery when eager-loading is set.
+	Edges        CreditEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CreditEdges holds the relations/edges for other nodes in the graph.
+type CreditEdges struct {
+	// Person holds the value of the person edge.
+	Person *Person `json:"person,omitempty"`
+	// Movie holds the value of the movie edge.
+	Movie *Movie `json:"movie,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// PersonOrErr returns the Person value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CreditEdges) PersonOrErr() (*Person, error) {
+	if e.Person != nil {
+		return e.Person, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: person.Label}
+	}
+	return nil, &NotLoadedError{edge: "person"}
+}
+
+// MovieOrErr returns the Movie value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CreditEdges) MovieOrErr() (*Movie, error) {
+	if e.Movie != nil {
+		return e.Movie, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: movie.Label}
+	}
+	return nil, &NotLoadedError{edge: "movie"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Credit) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case credit.FieldID, credit.FieldPersonID, credit.FieldMovieID:
+			values[i] = new(sql.NullInt64)
+		case credit.FieldRole, credit.FieldCharacter:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Credit fields.
+func (_m *Credit) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case credit.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case credit.FieldPersonID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field person_id", values[i])
+			} else if value.Valid {
+				_m.PersonID = int(value.Int64)
+			}
+		case credit.FieldMovieID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field movie_id", values[i])
+			} else if value.Valid {
+				_m.MovieID = int(value.Int64)
+			}
+		case credit.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				_m.Role = credit.Role(value.String)
+			}
+		case credit.FieldCharacter:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field character", values[i])
+			} else if value.Valid {
+				_m.Character = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Credit.
+// This includes values selected through modifiers, order, etc.
+func (_m *Credit) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryPerson queries the "person" edge of the Credit entity.
+func (_m *Credit) QueryPerson() *PersonQuery {
+	return NewCreditClient(_m.config).QueryPerson(_m)
+}
+
+// QueryMovie queries the "movie" edge of the Credit entity.
+func (_m *Credit) QueryMovie() *MovieQuery {
+	return NewCreditClient(_m.config).QueryMovie(_m)
+}
+
+// Update returns a builder for updating this Credit.
+// Note that you need to call Credit.Unwrap() before calling this method if this Credit
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Credit) Update() *CreditUpdateOne {
+	return NewCreditClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Credit entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Credit) Unwrap() *Credit {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Credit is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Credit) String() string {
+	var builder strings.Builder
+	builder.WriteString("Credit(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("person_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PersonID))
+	builder.WriteString(", ")
+	builder.WriteString("movie_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MovieID))
+	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Role))
+	builder.WriteString(", ")
+	builder.WriteString("character=")
+	builder.WriteString(_m.Character)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Credits is a parsable slice of Credit.
+type Credits []*Credit
@@ -0,0 +1,149 @@
+// Code generated by ent, DO NOT EDIT.
+
+package credit
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the credit type in the database.
+	Label = "credit"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPersonID holds the string denoting the person_id field in the database.
+	FieldPersonID = "person_id"
+	// FieldMovieID holds the string denoting the movie_id field in the database.
+	FieldMovieID = "movie_id"
+	// FieldRole holds the string denoting the role field in the database.
+	FieldRole = "role"
+	// FieldCharacter holds the string denoting the character field in the database.
+	FieldCharacter = "character"
+	// EdgePerson holds the string denoting the person edge name in mutations.
+	EdgePerson = "person"
+	// EdgeMovie holds the string denoting the movie edge name in mutations.
+	EdgeMovie = "movie"
+	// Table holds the table name of the credit in the database.
+	Table = "credits"
+	// PersonTable is the table that holds the person relation/edge.
+	PersonTable = "credits"
+	// PersonInverseTable is the table name for the Person entity.
+	// It exists in this package in order to avoid circular dependency with the "person" package.
+	PersonInverseTable = "persons"
+	// PersonColumn is the table column denoting the person relation/edge.
+	PersonColumn = "person_id"
+	// MovieTable is the table that holds the movie relation/edge.
+	MovieTable = "credits"
+	// MovieInverseTable is the table name for the Movie entity.
+	// It exists in this package in order to avoid circular dependency with the "movie" package.
+	MovieInverseTable = "movies"
+	// MovieColumn is the table column denoting the movie relation/edge.
+	MovieColumn = "movie_id"
+)
+
+// Columns holds all SQL columns for credit fields.
+var Columns = []string{
+	FieldID,
+	FieldPersonID,
+	FieldMovieID,
+	FieldRole,
+	FieldCharacter,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// CharacterValidator is a validator for the "character" field. It is called by the builders before save.
+	CharacterValidator func(string) error
+)
+
+// Role defines the type for the "role" enum field.
+type Role string
+
+// Role values.
+const (
+	RoleDirector Role = "director"
+	RoleActor    Role = "actor"
+	RoleStudio   Role = "studio"
+)
+
+func (r Role) String() string {
+	return string(r)
+}
+
+// RoleValidator is a validator for the "role" field enum values. It is called by the builders before save.
+func RoleValidator(r Role) error {
+	switch r {
+	case RoleDirector, RoleActor, RoleStudio:
+		return nil
+	default:
+		return fmt.Errorf("credit: invalid enum value for role field: %q", r)
+	}
+}
+
+// OrderOption defines the ordering options for the Credit queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByPersonID orders the results by the person_id field.
+func ByPersonID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPersonID, opts...).ToFunc()
+}
+
+// ByMovieID orders the results by the movie_id field.
+func ByMovieID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMovieID, opts...).ToFunc()
+}
+
+// ByRole orders the results by the role field.
+func ByRole(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRole, opts...).ToFunc()
+}
+
+// ByCharacter orders the results by the character field.
+func ByCharacter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCharacter, opts...).ToFunc()
+}
+
+// ByPersonField orders the results by person field.
+func ByPersonField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPersonStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByMovieField orders the results by movie field.
+func ByMovieField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMovieStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newPersonStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PersonInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PersonTable, PersonColumn),
+	)
+}
+func newMovieStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MovieInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, MovieTable, MovieColumn),
+	)
+}
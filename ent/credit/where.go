@@ -0,0 +1,266 @@
+// Code generated by ent, DO NOT EDIT.
+
+package credit
+
+import (
+	"watchlist-app/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Credit {
+	return predicate.Credit(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Credit {
+	return predicate.Credit(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Credit {
+	return predicate.Credit(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Credit {
+	return predicate.Credit(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Credit {
+	return predicate.Credit(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Credit {
+	return predicate.Credit(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Credit {
+	return predicate.Credit(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Credit {
+	return predicate.Credit(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Credit {
+	return predicate.Credit(sql.FieldLTE(FieldID, id))
+}
+
+// PersonID applies equality check predicate on the "person_id" field. It's identical to PersonIDEQ.
+func PersonID(v int) predicate.Credit {
+	return predicate.Credit(sql.FieldEQ(FieldPersonID, v))
+}
+
+// MovieID applies equality check predicate on the "movie_id" field. It's identical to MovieIDEQ.
+func MovieID(v int) predicate.Credit {
+	return predicate.Credit(sql.FieldEQ(FieldMovieID, v))
+}
+
+// Character applies equality check predicate on the "character" field. It's identical to CharacterEQ.
+func Character(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldEQ(FieldCharacter, v))
+}
+
+// PersonIDEQ applies the EQ predicate on the "person_id" field.
+func PersonIDEQ(v int) predicate.Credit {
+	return predicate.Credit(sql.FieldEQ(FieldPersonID, v))
+}
+
+// PersonIDNEQ applies the NEQ predicate on the "person_id" field.
+func PersonIDNEQ(v int) predicate.Credit {
+	return predicate.Credit(sql.FieldNEQ(FieldPersonID, v))
+}
+
+// PersonIDIn applies the In predicate on the "person_id" field.
+func PersonIDIn(vs ...int) predicate.Credit {
+	return predicate.Credit(sql.FieldIn(FieldPersonID, vs...))
+}
+
+// PersonIDNotIn applies the NotIn predicate on the "person_id" field.
+func PersonIDNotIn(vs ...int) predicate.Credit {
+	return predicate.Credit(sql.FieldNotIn(FieldPersonID, vs...))
+}
+
+// MovieIDEQ applies the EQ predicate on the "movie_id" field.
+func MovieIDEQ(v int) predicate.Credit {
+	return predicate.Credit(sql.FieldEQ(FieldMovieID, v))
+}
+
+// MovieIDNEQ applies the NEQ predicate on the "movie_id" field.
+func MovieIDNEQ(v int) predicate.Credit {
+	return predicate.Credit(sql.FieldNEQ(FieldMovieID, v))
+}
+
+// MovieIDIn applies the In predicate on the "movie_id" field.
+func MovieIDIn(vs ...int) predicate.Credit {
+	return predicate.Credit(sql.FieldIn(FieldMovieID, vs...))
+}
+
+// MovieIDNotIn applies the NotIn predicate on the "movie_id" field.
+func MovieIDNotIn(vs ...int) predicate.Credit {
+	return predicate.Credit(sql.FieldNotIn(FieldMovieID, vs...))
+}
+
+// RoleEQ applies the EQ predicate on the "role" field.
+func RoleEQ(v Role) predicate.Credit {
+	return predicate.Credit(sql.FieldEQ(FieldRole, v))
+}
+
+// RoleNEQ applies the NEQ predicate on the "role" field.
+func RoleNEQ(v Role) predicate.Credit {
+	return predicate.Credit(sql.FieldNEQ(FieldRole, v))
+}
+
+// RoleIn applies the In predicate on the "role" field.
+func RoleIn(vs ...Role) predicate.Credit {
+	return predicate.Credit(sql.FieldIn(FieldRole, vs...))
+}
+
+// RoleNotIn applies the NotIn predicate on the "role" field.
+func RoleNotIn(vs ...Role) predicate.Credit {
+	return predicate.Credit(sql.FieldNotIn(FieldRole, vs...))
+}
+
+// CharacterEQ applies the EQ predicate on the "character" field.
+func CharacterEQ(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldEQ(FieldCharacter, v))
+}
+
+// CharacterNEQ applies the NEQ predicate on the "character" field.
+func CharacterNEQ(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldNEQ(FieldCharacter, v))
+}
+
+// CharacterIn applies the In predicate on the "character" field.
+func CharacterIn(vs ...string) predicate.Credit {
+	return predicate.Credit(sql.FieldIn(FieldCharacter, vs...))
+}
+
+// CharacterNotIn applies the NotIn predicate on the "character" field.
+func CharacterNotIn(vs ...string) predicate.Credit {
+	return predicate.Credit(sql.FieldNotIn(FieldCharacter, vs...))
+}
+
+// CharacterGT applies the GT predicate on the "character" field.
+func CharacterGT(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldGT(FieldCharacter, v))
+}
+
+// CharacterGTE applies the GTE predicate on the "character" field.
+func CharacterGTE(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldGTE(FieldCharacter, v))
+}
+
+// CharacterLT applies the LT predicate on the "character" field.
+func CharacterLT(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldLT(FieldCharacter, v))
+}
+
+// CharacterLTE applies the LTE predicate on the "character" field.
+func CharacterLTE(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldLTE(FieldCharacter, v))
+}
+
+// CharacterContains applies the Contains predicate on the "character" field.
+func CharacterContains(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldContains(FieldCharacter, v))
+}
+
+// CharacterHasPrefix applies the HasPrefix predicate on the "character" field.
+func CharacterHasPrefix(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldHasPrefix(FieldCharacter, v))
+}
+
+// CharacterHasSuffix applies the HasSuffix predicate on the "character" field.
+func CharacterHasSuffix(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldHasSuffix(FieldCharacter, v))
+}
+
+// CharacterIsNil applies the IsNil predicate on the "character" field.
+func CharacterIsNil() predicate.Credit {
+	return predicate.Credit(sql.FieldIsNull(FieldCharacter))
+}
+
+// CharacterNotNil applies the NotNil predicate on the "character" field.
+func CharacterNotNil() predicate.Credit {
+	return predicate.Credit(sql.FieldNotNull(FieldCharacter))
+}
+
+// CharacterEqualFold applies the EqualFold predicate on the "character" field.
+func CharacterEqualFold(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldEqualFold(FieldCharacter, v))
+}
+
+// CharacterContainsFold applies the ContainsFold predicate on the "character" field.
+func CharacterContainsFold(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldContainsFold(FieldCharacter, v))
+}
+
+// HasPerson applies the HasEdge predicate on the "person" edge.
+func HasPerson() predicate.Credit {
+	return predicate.Credit(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PersonTable, PersonColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPersonWith applies the HasEdge predicate on the "person" edge with a given conditions (other predicates).
+func HasPersonWith(preds ...predicate.Person) predicate.Credit {
+	return predicate.Credit(func(s *sql.Selector) {
+		step := newPersonStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMovie applies the HasEdge predicate on the "movie" edge.
+func HasMovie() predicate.Credit {
+	return predicate.Credit(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, MovieTable, MovieColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMovieWith applies the HasEdge predicate on the "movie" edge with a given conditions (other predicates).
+func HasMovieWith(preds ...predicate.Movie) predicate.Credit {
+	return predicate.Credit(func(s *sql.Selector) {
+		step := newMovieStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Credit) predicate.Credit {
+	return predicate.Credit(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Credit) predicate.Credit {
+	return predicate.Credit(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Credit) predicate.Credit {
+	return predicate.Credit(sql.NotPredicates(p))
+}
@@ -0,0 +1,281 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"watchlist-app/ent/credit"
+	"watchlist-app/ent/movie"
+	"watchlist-app/ent/person"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CreditCreate is the builder for creating a Credit entity.
+type CreditCreate struct {
+	config
+	mutation *CreditMutation
+	hooks    []Hook
+}
+
+// SetPersonID sets the "person_id" field.
+func (_c *CreditCreate) SetPersonID(v int) *CreditCreate {
+	_c.mutation.SetPersonID(v)
+	return _c
+}
+
+// SetMovieID sets the "movie_id" field.
+func (_c *CreditCreate) SetMovieID(v int) *CreditCreate {
+	_c.mutation.SetMovieID(v)
+	return _c
+}
+
+// SetRole sets the "role" field.
+func (_c *CreditCreate) SetRole(v credit.Role) *CreditCreate {
+	_c.mutation.SetRole(v)
+	return _c
+}
+
+// SetCharacter sets the "character" field.
+func (_c *CreditCreate) SetCharacter(v string) *CreditCreate {
+	_c.mutation.SetCharacter(v)
+	return _c
+}
+
+// SetNillableCharacter sets the "character" field if the given value is not nil.
+func (_c *CreditCreate) SetNillableCharacter(v *string) *CreditCreate {
+	if v != nil {
+		_c.SetCharacter(*v)
+	}
+	return _c
+}
+
+// SetPerson sets the "person" edge to the Person entity.
+func (_c *CreditCreate) SetPerson(v *Person) *CreditCreate {
+	return _c.SetPersonID(v.ID)
+}
+
+// SetMovie sets the "movie" edge to the Movie entity.
+func (_c *CreditCreate) SetMovie(v *Movie) *CreditCreate {
+	return _c.SetMovieID(v.ID)
+}
+
+// Mutation returns the CreditMutation object of the builder.
+func (_c *CreditCreate) Mutation() *CreditMutation {
+	return _c.mutation
+}
+
+// Save creates the Credit in the database.
+func (_c *CreditCreate) Save(ctx context.Context) (*Credit, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CreditCreate) SaveX(ctx context.Context) *Credit {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CreditCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CreditCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CreditCreate) check() error {
+	if _, ok := _c.mutation.PersonID(); !ok {
+		return &ValidationError{Name: "person_id", err: errors.New(`ent: missing required field "Credit.person_id"`)}
+	}
+	if _, ok := _c.mutation.MovieID(); !ok {
+		return &ValidationError{Name: "movie_id", err: errors.New(`ent: missing required field "Credit.movie_id"`)}
+	}
+	if _, ok := _c.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "Credit.role"`)}
+	}
+	if v, ok := _c.mutation.Role(); ok {
+		if err := credit.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "Credit.role": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Character(); ok {
+		if err := credit.CharacterValidator(v); err != nil {
+			return &ValidationError{Name: "character", err: fmt.Errorf(`ent: validator failed for field "Credit.character": %w`, err)}
+		}
+	}
+	if len(_c.mutation.PersonIDs()) == 0 {
+		return &ValidationError{Name: "person", err: errors.New(`ent: missing required edge "Credit.person"`)}
+	}
+	if len(_c.mutation.MovieIDs()) == 0 {
+		return &ValidationError{Name: "movie", err: errors.New(`ent: missing required edge "Credit.movie"`)}
+	}
+	return nil
+}
+
+func (_c *CreditCreate) sqlSave(ctx context.Context) (*Credit, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CreditCreate) createSpec() (*Credit, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Credit{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(credit.Table, sqlgraph.NewFieldSpec(credit.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Role(); ok {
+		_spec.SetField(credit.FieldRole, field.TypeEnum, value)
+		_node.Role = value
+	}
+	if value, ok := _c.mutation.Character(); ok {
+		_spec.SetField(credit.FieldCharacter, field.TypeString, value)
+		_node.Character = value
+	}
+	if nodes := _c.mutation.PersonIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   credit.PersonTable,
+			Columns: []string{credit.PersonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(person.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PersonID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MovieIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   credit.MovieTable,
+			Columns: []string{credit.MovieColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(movie.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MovieID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CreditCreateBulk is the builder for creating many Credit entities in bulk.
+type CreditCreateBulk struct {
+	config
+	err      error
+	builders []*CreditCreate
+}
+
+// Save creates the Credit entities in the database.
+func (_c *CreditCreateBulk) Save(ctx context.Context) ([]*Credit, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Credit, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CreditMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CreditCreateBulk) SaveX(ctx context.Context) []*Credit {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CreditCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CreditCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
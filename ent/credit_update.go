@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"watchlist-app/ent/credit"
+	"watchlist-app/ent/movie"
+	"watchlist-app/ent/person"
+	"watchlist-app/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CreditUpdate is the builder for updating Credit entities.
+type CreditUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CreditMutation
+}
+
+// Where appends a list predicates to the CreditUpdate builder.
+func (_u *CreditUpdate) Where(ps ...predicate.Credit) *CreditUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPersonID sets the "person_id" field.
+func (_u *CreditUpdate) SetPersonID(v int) *CreditUpdate {
+	_u.mutation.SetPersonID(v)
+	return _u
+}
+
+// SetNillablePersonID sets the "person_id" field if the given value is not nil.
+func (_u *CreditUpdate) SetNillablePersonID(v *int) *CreditUpdate {
+	if v != nil {
+		_u.SetPersonID(*v)
+	}
+	return _u
+}
+
+// SetMovieID sets the "movie_id" field.
+func (_u *CreditUpdate) SetMovieID(v int) *CreditUpdate {
+	_u.mutation.SetMovieID(v)
+	return _u
+}
+
+// SetNillableMovieID sets the "movie_id" field if the given value is not nil.
+func (_u *CreditUpdate) SetNillableMovieID(v *int) *CreditUpdate {
+	if v != nil {
+		_u.SetMovieID(*v)
+	}
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *CreditUpdate) SetRole(v credit.Role) *CreditUpdate {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *CreditUpdate) SetNillableRole(v *credit.Role) *CreditUpdate {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// SetCharacter sets the "character" field.
+func (_u *CreditUpdate) SetCharacter(v string) *CreditUpdate {
+	_u.mutation.SetCharacter(v)
+	return _u
+}
+
+// SetNillableCharacter sets the "character" field if the given value is not nil.
+func (_u *CreditUpdate) SetNillableCharacter(v *string) *CreditUpdate {
+	if v != nil {
+		_u.SetCharacter(*v)
+	}
+	return _u
+}
+
+// ClearCharacter clears the value of the "character" field.
+func (_u *CreditUpdate) ClearCharacter() *CreditUpdate {
+	_u.mutation.ClearCharacter()
+	return _u
+}
+
+// SetPerson sets the "person" edge to the Person entity.
+func (_u *CreditUpdate) SetPerson(v *Person) *CreditUpdate {
+	return _u.SetPersonID(v.ID)
+}
+
+// SetMovie sets the "movie" edge to the Movie entity.
+func (_u *CreditUpdate) SetMovie(v *Movie) *CreditUpdate {
+	return _u.SetMovieID(v.ID)
+}
+
+// Mutation returns the CreditMutation object of the builder.
+func (_u *CreditUpdate) Mutation() *CreditMutation {
+	return _u.mutation
+}
+
+// ClearPerson clears the "person" edge to the Person entity.
+func (_u *CreditUpdate) ClearPerson() *CreditUpdate {
+	_u.mutation.ClearPerson()
+	return _u
+}
+
+// ClearMovie clears the "movie" edge to the Movie entity.
+func (_u *CreditUpdate) ClearMovie() *CreditUpdate {
+	_u.mutation.ClearMovie()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CreditUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CreditUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CreditUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CreditUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CreditUpdate) check() error {
+	if v, ok := _u.mutation.Role(); ok {
+		if err := credit.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "Credit.role": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Character(); ok {
+		if err := credit.CharacterValidator(v); err != nil {
+			return &ValidationError{Name: "character", err: fmt.Errorf(`ent: validator failed for field "Credit.character": %w`, err)}
+		}
+	}
+	if _u.mutation.PersonCleared() && len(_u.mutation.PersonIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Credit.person"`)
+	}
+	if _u.mutation.MovieCleared() && len(_u.mutation.MovieIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Credit.movie"`)
+	}
+	return nil
+}
+
+func (_u *CreditUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(credit.Table, credit.Columns, sqlgraph.NewFieldSpec(credit.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(credit.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Character(); ok {
+		_spec.SetField(credit.FieldCharacter, field.TypeString, value)
+	}
+	if _u.mutation.CharacterCleared() {
+		_spec.ClearField(credit.FieldCharacter, field.TypeString)
+	}
+	if _u.mutation.PersonCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   credit.PersonTable,
+			Columns: []string{credit.PersonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(person.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PersonIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   credit.PersonTable,
+			Columns: []string{credit.PersonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(person.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MovieCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   credit.MovieTable,
+			Columns: []string{credit.MovieColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(movie.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MovieIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   credit.MovieTable,
+			Columns: []string{credit.MovieColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(movie.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{credit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CreditUpdateOne is the builder for updating a single Credit entity.
+type CreditUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CreditMutation
+}
+
+// SetPersonID sets the "person_id" field.
+func (_u *CreditUpdateOne) SetPersonID(v int) *CreditUpdateOne {
+	_u.mutation.SetPersonID(v)
+	return _u
+}
+
+// SetNillablePersonID sets the "person_id" field if the given value is not nil.
+func (_u *CreditUpdateOne) SetNillablePersonID(v *int) *CreditUpdateOne {
+	if v != nil {
+		_u.SetPersonID(*v)
+	}
+	return _u
+}
+
+// SetMovieID sets the "movie_id" field.
+func (_u *CreditUpdateOne) SetMovieID(v int) *CreditUpdateOne {
+	_u.mutation.SetMovieID(v)
+	return _u
+}
+
+// SetNillableMovieID sets the "movie_id" field if the given value is not nil.
+func (_u *CreditUpdateOne) SetNillableMovieID(v *int) *CreditUpdateOne {
+	if v != nil {
+		_u.SetMovieID(*v)
+	}
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *CreditUpdateOne) SetRole(v credit.Role) *CreditUpdateOne {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *CreditUpdateOne) SetNillableRole(v *credit.Role) *CreditUpdateOne {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// SetCharacter sets the "character" field.
+func (_u *CreditUpdateOne) SetCharacter(v string) *CreditUpdateOne {
+	_u.mutation.SetCharacter(v)
+	return _u
+}
+
+// SetNillableCharacter sets the "character" field if the given value is not nil.
+func (_u *CreditUpdateOne) SetNillableCharacter(v *string) *CreditUpdateOne {
+	if v != nil {
+		_u.SetCharacter(*v)
+	}
+	return _u
+}
+
+// ClearCharacter clears the value of the "character" field.
+func (_u *CreditUpdateOne) ClearCharacter() *CreditUpdateOne {
+	_u.mutation.ClearCharacter()
+	return _u
+}
+
+// SetPerson sets the "person" edge to the Person entity.
+func (_u *CreditUpdateOne) SetPerson(v *Person) *CreditUpdateOne {
+	return _u.SetPersonID(v.ID)
+}
+
+// SetMovie sets the "movie" edge to the Movie entity.
+func (_u *CreditUpdateOne) SetMovie(v *Movie) *CreditUpdateOne {
+	return _u.SetMovieID(v.ID)
+}
+
+// Mutation returns the CreditMutation object of the builder.
+func (_u *CreditUpdateOne) Mutation() *CreditMutation {
+	return _u.mutation
+}
+
+// ClearPerson clears the "person" edge to the Person entity.
+func (_u *CreditUpdateOne) ClearPerson() *CreditUpdateOne {
+	_u.mutation.ClearPerson()
+	return _u
+}
+
+// ClearMovie clears the "movie" edge to the Movie entity.
+func (_u *CreditUpdateOne) ClearMovie() *CreditUpdateOne {
+	_u.mutation.ClearMovie()
+	return _u
+}
+
+// Where appends a list predicates to the CreditUpdate builder.
+func (_u *CreditUpdateOne) Where(ps ...predicate.Credit) *CreditUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CreditUpdateOne) Select(field string, fields ...string) *CreditUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Credit entity.
+func (_u *CreditUpdateOne) Save(ctx context.Context) (*Credit, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CreditUpdateOne) SaveX(ctx context.Context) *Credit {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CreditUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CreditUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CreditUpdateOne) check() error {
+	if v, ok := _u.mutation.Role(); ok {
+		if err := credit.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "Credit.role": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Character(); ok {
+		if err := credit.CharacterValidator(v); err != nil {
+			return &ValidationError{Name: "character", err: fmt.Errorf(`ent: validator failed for field "Credit.character": %w`, err)}
+		}
+	}
+	if _u.mutation.PersonCleared() && len(_u.mutation.PersonIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Credit.person"`)
+	}
+	if _u.mutation.MovieCleared() && len(_u.mutation.MovieIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Credit.movie"`)
+	}
+	return nil
+}
+
+func (_u *CreditUpdateOne) sqlSave(ctx context.Context) (_node *Credit, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(credit.Table, credit.Columns, sqlgraph.NewFieldSpec(credit.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Credit.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, credit.FieldID)
+		for _, f := range fields {
+			if !credit.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != credit.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(credit.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Character(); ok {
+		_spec.SetField(credit.FieldCharacter, field.TypeString, value)
+	}
+	if _u.mutation.CharacterCleared() {
+		_spec.ClearField(credit.FieldCharacter, field.TypeString)
+	}
+	if _u.mutation.PersonCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   credit.PersonTable,
+			Columns: []string{credit.PersonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(person.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PersonIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   credit.PersonTable,
+			Columns: []string{credit.PersonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(person.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MovieCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   credit.MovieTable,
+			Columns: []string{credit.MovieColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(movie.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MovieIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   credit.MovieTable,
+			Columns: []string{credit.MovieColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(movie.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Credit{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{credit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
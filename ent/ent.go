@@ -8,7 +8,22 @@ import (
 	"fmt"
 	"reflect"
 	"sync"
+	"watchlist-app/ent/auditentry"
+	"watchlist-app/ent/availability"
+	"watchlist-app/ent/credit"
+	"watchlist-app/ent/episode"
+	"watchlist-app/ent/list"
+	"watchlist-app/ent/listentry"
 	"watchlist-app/ent/movie"
+	"watchlist-app/ent/person"
+	"watchlist-app/ent/platform"
+	"watchlist-app/ent/refreshtoken"
+	"watchlist-app/ent/season"
+	"watchlist-app/ent/tag"
+	"watchlist-app/ent/user"
+	"watchlist-app/ent/watchevent"
+	"watchlist-app/ent/webhook"
+	"watchlist-app/ent/webhookdelivery"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -73,7 +88,22 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			movie.Table: movie.ValidColumn,
+			auditentry.Table:      auditentry.ValidColumn,
+			availability.Table:    availability.ValidColumn,
+			credit.Table:          credit.ValidColumn,
+			episode.Table:         episode.ValidColumn,
+			list.Table:            list.ValidColumn,
+			listentry.Table:       listentry.ValidColumn,
+			movie.Table:           movie.ValidColumn,
+			person.Table:          person.ValidColumn,
+			platform.Table:        platform.ValidColumn,
+			refreshtoken.Table:    refreshtoken.ValidColumn,
+			season.Table:          season.ValidColumn,
+			tag.Table:             tag.ValidColumn,
+			user.Table:            user.ValidColumn,
+			watchevent.Table:      watchevent.ValidColumn,
+			webhook.Table:         webhook.ValidColumn,
+			webhookdelivery.Table: webhookdelivery.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -0,0 +1,23 @@
+//go:build ignore
+
+package main
+
+import (
+	"log"
+
+	"entgo.io/ent/entc"
+	"entgo.io/ent/entc/gen"
+
+	"watchlist-app/pkg/entgraphql/sdl"
+)
+
+// ent のコードと、entgraphql のアノテーションから GraphQL の型定義（internal/graph/ent.graphqls）を生成する
+func main() {
+	err := entc.Generate("./schema", &gen.Config{
+		Features: []gen.Feature{gen.FeatureIntercept, gen.FeatureExecQuery},
+		Hooks:    []gen.Hook{sdl.Hook("../internal/graph/ent.graphqls")},
+	})
+	if err != nil {
+		log.Fatalf("running ent codegen: %v", err)
+	}
+}
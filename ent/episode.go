@@ -0,0 +1,182 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"watchlist-app/ent/episode"
+	"watchlist-app/ent/season"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Episode is the model entity for the Episode schema.
+type Episode struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// シーズンID
+	SeasonID int `json:"season_id,omitempty"`
+	// 話数
+	Number int `json:"number,omitempty"`
+	// エピソードタイトル
+	Title string `json:"title,omitempty"`
+	// 視聴日時（未視聴の場合は NULL）
+	WatchedAt *time.Time `json:"watched_at,omitempty"`
+	// 作成日時
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the EpisodeQuery when eager-loading is set.
+	Edges        EpisodeEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// EpisodeEdges holds the relations/edges for other nodes in the graph.
+type EpisodeEdges struct {
+	// Season holds the value of the season edge.
+	Season *Season `json:"season,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// SeasonOrErr returns the Season value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EpisodeEdges) SeasonOrErr() (*Season, error) {
+	if e.Season != nil {
+		return e.Season, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: season.Label}
+	}
+	return nil, &NotLoadedError{edge: "season"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Episode) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case episode.FieldID, episode.FieldSeasonID, episode.FieldNumber:
+			values[i] = new(sql.NullInt64)
+		case episode.FieldTitle:
+			values[i] = new(sql.NullString)
+		case episode.FieldWatchedAt, episode.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Episode fields.
+func (_m *Episode) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case episode.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case episode.FieldSeasonID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field season_id", values[i])
+			} else if value.Valid {
+				_m.SeasonID = int(value.Int64)
+			}
+		case episode.FieldNumber:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field number", values[i])
+			} else if value.Valid {
+				_m.Number = int(value.Int64)
+			}
+		case episode.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				_m.Title = value.String
+			}
+		case episode.FieldWatchedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field watched_at", values[i])
+			} else if value.Valid {
+				_m.WatchedAt = new(time.Time)
+				*_m.WatchedAt = value.Time
+			}
+		case episode.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Episode.
+// This includes values selected through modifiers, order, etc.
+func (_m *Episode) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QuerySeason queries the "season" edge of the Episode entity.
+func (_m *Episode) QuerySeason() *SeasonQuery {
+	return NewEpisodeClient(_m.config).QuerySeason(_m)
+}
+
+// Update returns a builder for updating this Episode.
+// Note that you need to call Episode.Unwrap() before calling this method if this Episode
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Episode) Update() *EpisodeUpdateOne {
+	return NewEpisodeClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Episode entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Episode) Unwrap() *Episode {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Episode is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Episode) String() string {
+	var builder strings.Builder
+	builder.WriteString("Episode(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("season_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SeasonID))
+	builder.WriteString(", ")
+	builder.WriteString("number=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Number))
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(_m.Title)
+	builder.WriteString(", ")
+	if v := _m.WatchedAt; v != nil {
+		builder.WriteString("watched_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Episodes is a parsable slice of Episode.
+type Episodes []*Episode
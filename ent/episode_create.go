@@ -0,0 +1,291 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"watchlist-app/ent/episode"
+	"watchlist-app/ent/season"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EpisodeCreate is the builder for creating a Episode entity.
+type EpisodeCreate struct {
+	config
+	mutation *EpisodeMutation
+	hooks    []Hook
+}
+
+// SetSeasonID sets the "season_id" field.
+func (_c *EpisodeCreate) SetSeasonID(v int) *EpisodeCreate {
+	_c.mutation.SetSeasonID(v)
+	return _c
+}
+
+// SetNumber sets the "number" field.
+func (_c *EpisodeCreate) SetNumber(v int) *EpisodeCreate {
+	_c.mutation.SetNumber(v)
+	return _c
+}
+
+// SetTitle sets the "title" field.
+func (_c *EpisodeCreate) SetTitle(v string) *EpisodeCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_c *EpisodeCreate) SetNillableTitle(v *string) *EpisodeCreate {
+	if v != nil {
+		_c.SetTitle(*v)
+	}
+	return _c
+}
+
+// SetWatchedAt sets the "watched_at" field.
+func (_c *EpisodeCreate) SetWatchedAt(v time.Time) *EpisodeCreate {
+	_c.mutation.SetWatchedAt(v)
+	return _c
+}
+
+// SetNillableWatchedAt sets the "watched_at" field if the given value is not nil.
+func (_c *EpisodeCreate) SetNillableWatchedAt(v *time.Time) *EpisodeCreate {
+	if v != nil {
+		_c.SetWatchedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *EpisodeCreate) SetCreatedAt(v time.Time) *EpisodeCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *EpisodeCreate) SetNillableCreatedAt(v *time.Time) *EpisodeCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetSeason sets the "season" edge to the Season entity.
+func (_c *EpisodeCreate) SetSeason(v *Season) *EpisodeCreate {
+	return _c.SetSeasonID(v.ID)
+}
+
+// Mutation returns the EpisodeMutation object of the builder.
+func (_c *EpisodeCreate) Mutation() *EpisodeMutation {
+	return _c.mutation
+}
+
+// Save creates the Episode in the database.
+func (_c *EpisodeCreate) Save(ctx context.Context) (*Episode, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EpisodeCreate) SaveX(ctx context.Context) *Episode {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EpisodeCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EpisodeCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EpisodeCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := episode.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EpisodeCreate) check() error {
+	if _, ok := _c.mutation.SeasonID(); !ok {
+		return &ValidationError{Name: "season_id", err: errors.New(`ent: missing required field "Episode.season_id"`)}
+	}
+	if _, ok := _c.mutation.Number(); !ok {
+		return &ValidationError{Name: "number", err: errors.New(`ent: missing required field "Episode.number"`)}
+	}
+	if v, ok := _c.mutation.Number(); ok {
+		if err := episode.NumberValidator(v); err != nil {
+			return &ValidationError{Name: "number", err: fmt.Errorf(`ent: validator failed for field "Episode.number": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Episode.created_at"`)}
+	}
+	if len(_c.mutation.SeasonIDs()) == 0 {
+		return &ValidationError{Name: "season", err: errors.New(`ent: missing required edge "Episode.season"`)}
+	}
+	return nil
+}
+
+func (_c *EpisodeCreate) sqlSave(ctx context.Context) (*Episode, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EpisodeCreate) createSpec() (*Episode, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Episode{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(episode.Table, sqlgraph.NewFieldSpec(episode.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Number(); ok {
+		_spec.SetField(episode.FieldNumber, field.TypeInt, value)
+		_node.Number = value
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(episode.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := _c.mutation.WatchedAt(); ok {
+		_spec.SetField(episode.FieldWatchedAt, field.TypeTime, value)
+		_node.WatchedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(episode.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.SeasonIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   episode.SeasonTable,
+			Columns: []string{episode.SeasonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(season.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SeasonID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// EpisodeCreateBulk is the builder for creating many Episode entities in bulk.
+type EpisodeCreateBulk struct {
+	config
+	err      error
+	builders []*EpisodeCreate
+}
+
+// Save creates the Episode entities in the database.
+func (_c *EpisodeCreateBulk) Save(ctx context.Context) ([]*Episode, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Episode, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EpisodeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EpisodeCreateBulk) SaveX(ctx context.Context) []*Episode {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EpisodeCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EpisodeCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,211 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"watchlist-app/ent/list"
+	"watchlist-app/ent/user"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// List is the model entity for the List schema.
+type List struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// リスト名
+	Name string `json:"name,omitempty"`
+	// リストの説明
+	Description string `json:"description,omitempty"`
+	// 所有ユーザーID
+	UserID int `json:"user_id,omitempty"`
+	// 作成日時
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新日時
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ListQuery when eager-loading is set.
+	Edges        ListEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ListEdges holds the relations/edges for other nodes in the graph.
+type ListEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
+	// Movies holds the value of the movies edge.
+	Movies []*Movie `json:"movies,omitempty"`
+	// Entries holds the value of the entries edge.
+	Entries []*ListEntry `json:"entries,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ListEdges) OwnerOrErr() (*User, error) {
+	if e.Owner != nil {
+		return e.Owner, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// MoviesOrErr returns the Movies value or an error if the edge
+// was not loaded in eager-loading.
+func (e ListEdges) MoviesOrErr() ([]*Movie, error) {
+	if e.loadedTypes[1] {
+		return e.Movies, nil
+	}
+	return nil, &NotLoadedError{edge: "movies"}
+}
+
+// EntriesOrErr returns the Entries value or an error if the edge
+// was not loaded in eager-loading.
+func (e ListEdges) EntriesOrErr() ([]*ListEntry, error) {
+	if e.loadedTypes[2] {
+		return e.Entries, nil
+	}
+	return nil, &NotLoadedError{edge: "entries"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*List) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case list.FieldID, list.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case list.FieldName, list.FieldDescription:
+			values[i] = new(sql.NullString)
+		case list.FieldCreatedAt, list.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the List fields.
+func (_m *List) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case list.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case list.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case list.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case list.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = int(value.Int64)
+			}
+		case list.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case list.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the List.
+// This includes values selected through modifiers, order, etc.
+func (_m *List) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryOwner queries the "owner" edge of the List entity.
+func (_m *List) QueryOwner() *UserQuery {
+	return NewListClient(_m.config).QueryOwner(_m)
+}
+
+// QueryMovies queries the "movies" edge of the List entity.
+func (_m *List) QueryMovies() *MovieQuery {
+	return NewListClient(_m.config).QueryMovies(_m)
+}
+
+// QueryEntries queries the "entries" edge of the List entity.
+func (_m *List) QueryEntries() *ListEntryQuery {
+	return NewListClient(_m.config).QueryEntries(_m)
+}
+
+// Update returns a builder for updating this List.
+// Note that you need to call List.Unwrap() before calling this method if this List
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *List) Update() *ListUpdateOne {
+	return NewListClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the List entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *List) Unwrap() *List {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: List is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *List) String() string {
+	var builder strings.Builder
+	builder.WriteString("List(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Lists is a parsable slice of List.
+type Lists []*List
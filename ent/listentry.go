@@ -0,0 +1,176 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"watchlist-app/ent/list"
+	"watchlist-app/ent/listentry"
+	"watchlist-app/ent/movie"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ListEntry is the model entity for the ListEntry schema.
+type ListEntry struct {
+	config `json:"-"`
+	// リストID
+	ListID int `json:"list_id,omitempty"`
+	// 作品ID
+	MovieID int `json:"movie_id,omitempty"`
+	// リスト内の並び順（0始まり）
+	Position int `json:"position,omitempty"`
+	// 追加日時
+	AddedAt time.Time `json:"added_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ListEntryQuery when eager-loading is set.
+	Edges        ListEntryEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ListEntryEdges holds the relations/edges for other nodes in the graph.
+type ListEntryEdges struct {
+	// List holds the value of the list edge.
+	List *List `json:"list,omitempty"`
+	// Movie holds the value of the movie edge.
+	Movie *Movie `json:"movie,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ListOrErr returns the List value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ListEntryEdges) ListOrErr() (*List, error) {
+	if e.List != nil {
+		return e.List, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: list.Label}
+	}
+	return nil, &NotLoadedError{edge: "list"}
+}
+
+// MovieOrErr returns the Movie value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ListEntryEdges) MovieOrErr() (*Movie, error) {
+	if e.Movie != nil {
+		return e.Movie, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: movie.Label}
+	}
+	return nil, &NotLoadedError{edge: "movie"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ListEntry) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case listentry.FieldListID, listentry.FieldMovieID, listentry.FieldPosition:
+			values[i] = new(sql.NullInt64)
+		case listentry.FieldAddedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ListEntry fields.
+func (_m *ListEntry) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case listentry.FieldListID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field list_id", values[i])
+			} else if value.Valid {
+				_m.ListID = int(value.Int64)
+			}
+		case listentry.FieldMovieID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field movie_id", values[i])
+			} else if value.Valid {
+				_m.MovieID = int(value.Int64)
+			}
+		case listentry.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				_m.Position = int(value.Int64)
+			}
+		case listentry.FieldAddedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field added_at", values[i])
+			} else if value.Valid {
+				_m.AddedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ListEntry.
+// This includes values selected through modifiers, order, etc.
+func (_m *ListEntry) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryList queries the "list" edge of the ListEntry entity.
+func (_m *ListEntry) QueryList() *ListQuery {
+	return NewListEntryClient(_m.config).QueryList(_m)
+}
+
+// QueryMovie queries the "movie" edge of the ListEntry entity.
+func (_m *ListEntry) QueryMovie() *MovieQuery {
+	return NewListEntryClient(_m.config).QueryMovie(_m)
+}
+
+// Update returns a builder for updating this ListEntry.
+// Note that you need to call ListEntry.Unwrap() before calling this method if this ListEntry
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ListEntry) Update() *ListEntryUpdateOne {
+	return NewListEntryClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ListEntry entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ListEntry) Unwrap() *ListEntry {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ListEntry is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ListEntry) String() string {
+	var builder strings.Builder
+	builder.WriteString("ListEntry(")
+	builder.WriteString("list_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ListID))
+	builder.WriteString(", ")
+	builder.WriteString("movie_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MovieID))
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Position))
+	builder.WriteString(", ")
+	builder.WriteString("added_at=")
+	builder.WriteString(_m.AddedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ListEntries is a parsable slice of ListEntry.
+type ListEntries []*ListEntry
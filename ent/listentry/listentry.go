@@ -0,0 +1,123 @@
+// Code generated by ent, DO NOT EDIT.
+
+package listentry
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the listentry type in the database.
+	Label = "list_entry"
+	// FieldListID holds the string denoting the list_id field in the database.
+	FieldListID = "list_id"
+	// FieldMovieID holds the string denoting the movie_id field in the database.
+	FieldMovieID = "movie_id"
+	// FieldPosition holds the string denoting the position field in the database.
+	FieldPosition = "position"
+	// FieldAddedAt holds the string denoting the added_at field in the database.
+	FieldAddedAt = "added_at"
+	// EdgeList holds the string denoting the list edge name in mutations.
+	EdgeList = "list"
+	// EdgeMovie holds the string denoting the movie edge name in mutations.
+	EdgeMovie = "movie"
+	// ListFieldID holds the string denoting the ID field of the List.
+	ListFieldID = "id"
+	// MovieFieldID holds the string denoting the ID field of the Movie.
+	MovieFieldID = "id"
+	// Table holds the table name of the listentry in the database.
+	Table = "list_entries"
+	// ListTable is the table that holds the list relation/edge.
+	ListTable = "list_entries"
+	// ListInverseTable is the table name for the List entity.
+	// It exists in this package in order to avoid circular dependency with the "list" package.
+	ListInverseTable = "lists"
+	// ListColumn is the table column denoting the list relation/edge.
+	ListColumn = "list_id"
+	// MovieTable is the table that holds the movie relation/edge.
+	MovieTable = "list_entries"
+	// MovieInverseTable is the table name for the Movie entity.
+	// It exists in this package in order to avoid circular dependency with the "movie" package.
+	MovieInverseTable = "movies"
+	// MovieColumn is the table column denoting the movie relation/edge.
+	MovieColumn = "movie_id"
+)
+
+// Columns holds all SQL columns for listentry fields.
+var Columns = []string{
+	FieldListID,
+	FieldMovieID,
+	FieldPosition,
+	FieldAddedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// PositionValidator is a validator for the "position" field. It is called by the builders before save.
+	PositionValidator func(int) error
+	// DefaultAddedAt holds the default value on creation for the "added_at" field.
+	DefaultAddedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the ListEntry queries.
+type OrderOption func(*sql.Selector)
+
+// ByListID orders the results by the list_id field.
+func ByListID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldListID, opts...).ToFunc()
+}
+
+// ByMovieID orders the results by the movie_id field.
+func ByMovieID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMovieID, opts...).ToFunc()
+}
+
+// ByPosition orders the results by the position field.
+func ByPosition(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPosition, opts...).ToFunc()
+}
+
+// ByAddedAt orders the results by the added_at field.
+func ByAddedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAddedAt, opts...).ToFunc()
+}
+
+// ByListField orders the results by list field.
+func ByListField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newListStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByMovieField orders the results by movie field.
+func ByMovieField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMovieStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newListStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, ListColumn),
+		sqlgraph.To(ListInverseTable, ListFieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, ListTable, ListColumn),
+	)
+}
+func newMovieStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, MovieColumn),
+		sqlgraph.To(MovieInverseTable, MovieFieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, MovieTable, MovieColumn),
+	)
+}
@@ -0,0 +1,281 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"watchlist-app/ent/list"
+	"watchlist-app/ent/listentry"
+	"watchlist-app/ent/movie"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ListEntryCreate is the builder for creating a ListEntry entity.
+type ListEntryCreate struct {
+	config
+	mutation *ListEntryMutation
+	hooks    []Hook
+}
+
+// SetListID sets the "list_id" field.
+func (_c *ListEntryCreate) SetListID(v int) *ListEntryCreate {
+	_c.mutation.SetListID(v)
+	return _c
+}
+
+// SetMovieID sets the "movie_id" field.
+func (_c *ListEntryCreate) SetMovieID(v int) *ListEntryCreate {
+	_c.mutation.SetMovieID(v)
+	return _c
+}
+
+// SetPosition sets the "position" field.
+func (_c *ListEntryCreate) SetPosition(v int) *ListEntryCreate {
+	_c.mutation.SetPosition(v)
+	return _c
+}
+
+// SetAddedAt sets the "added_at" field.
+func (_c *ListEntryCreate) SetAddedAt(v time.Time) *ListEntryCreate {
+	_c.mutation.SetAddedAt(v)
+	return _c
+}
+
+// SetNillableAddedAt sets the "added_at" field if the given value is not nil.
+func (_c *ListEntryCreate) SetNillableAddedAt(v *time.Time) *ListEntryCreate {
+	if v != nil {
+		_c.SetAddedAt(*v)
+	}
+	return _c
+}
+
+// SetList sets the "list" edge to the List entity.
+func (_c *ListEntryCreate) SetList(v *List) *ListEntryCreate {
+	return _c.SetListID(v.ID)
+}
+
+// SetMovie sets the "movie" edge to the Movie entity.
+func (_c *ListEntryCreate) SetMovie(v *Movie) *ListEntryCreate {
+	return _c.SetMovieID(v.ID)
+}
+
+// Mutation returns the ListEntryMutation object of the builder.
+func (_c *ListEntryCreate) Mutation() *ListEntryMutation {
+	return _c.mutation
+}
+
+// Save creates the ListEntry in the database.
+func (_c *ListEntryCreate) Save(ctx context.Context) (*ListEntry, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ListEntryCreate) SaveX(ctx context.Context) *ListEntry {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ListEntryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ListEntryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ListEntryCreate) defaults() {
+	if _, ok := _c.mutation.AddedAt(); !ok {
+		v := listentry.DefaultAddedAt()
+		_c.mutation.SetAddedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ListEntryCreate) check() error {
+	if _, ok := _c.mutation.ListID(); !ok {
+		return &ValidationError{Name: "list_id", err: errors.New(`ent: missing required field "ListEntry.list_id"`)}
+	}
+	if _, ok := _c.mutation.MovieID(); !ok {
+		return &ValidationError{Name: "movie_id", err: errors.New(`ent: missing required field "ListEntry.movie_id"`)}
+	}
+	if _, ok := _c.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "ListEntry.position"`)}
+	}
+	if v, ok := _c.mutation.Position(); ok {
+		if err := listentry.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "ListEntry.position": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AddedAt(); !ok {
+		return &ValidationError{Name: "added_at", err: errors.New(`ent: missing required field "ListEntry.added_at"`)}
+	}
+	if len(_c.mutation.ListIDs()) == 0 {
+		return &ValidationError{Name: "list", err: errors.New(`ent: missing required edge "ListEntry.list"`)}
+	}
+	if len(_c.mutation.MovieIDs()) == 0 {
+		return &ValidationError{Name: "movie", err: errors.New(`ent: missing required edge "ListEntry.movie"`)}
+	}
+	return nil
+}
+
+func (_c *ListEntryCreate) sqlSave(ctx context.Context) (*ListEntry, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
+
+func (_c *ListEntryCreate) createSpec() (*ListEntry, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ListEntry{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(listentry.Table, nil)
+	)
+	if value, ok := _c.mutation.Position(); ok {
+		_spec.SetField(listentry.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
+	if value, ok := _c.mutation.AddedAt(); ok {
+		_spec.SetField(listentry.FieldAddedAt, field.TypeTime, value)
+		_node.AddedAt = value
+	}
+	if nodes := _c.mutation.ListIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   listentry.ListTable,
+			Columns: []string{listentry.ListColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(list.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ListID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MovieIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   listentry.MovieTable,
+			Columns: []string{listentry.MovieColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(movie.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MovieID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ListEntryCreateBulk is the builder for creating many ListEntry entities in bulk.
+type ListEntryCreateBulk struct {
+	config
+	err      error
+	builders []*ListEntryCreate
+}
+
+// Save creates the ListEntry entities in the database.
+func (_c *ListEntryCreateBulk) Save(ctx context.Context) ([]*ListEntry, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ListEntry, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ListEntryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ListEntryCreateBulk) SaveX(ctx context.Context) []*ListEntry {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ListEntryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ListEntryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,487 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"watchlist-app/ent/list"
+	"watchlist-app/ent/listentry"
+	"watchlist-app/ent/movie"
+	"watchlist-app/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ListEntryUpdate is the builder for updating ListEntry entities.
+type ListEntryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ListEntryMutation
+}
+
+// Where appends a list predicates to the ListEntryUpdate builder.
+func (_u *ListEntryUpdate) Where(ps ...predicate.ListEntry) *ListEntryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetListID sets the "list_id" field.
+func (_u *ListEntryUpdate) SetListID(v int) *ListEntryUpdate {
+	_u.mutation.SetListID(v)
+	return _u
+}
+
+// SetNillableListID sets the "list_id" field if the given value is not nil.
+func (_u *ListEntryUpdate) SetNillableListID(v *int) *ListEntryUpdate {
+	if v != nil {
+		_u.SetListID(*v)
+	}
+	return _u
+}
+
+// SetMovieID sets the "movie_id" field.
+func (_u *ListEntryUpdate) SetMovieID(v int) *ListEntryUpdate {
+	_u.mutation.SetMovieID(v)
+	return _u
+}
+
+// SetNillableMovieID sets the "movie_id" field if the given value is not nil.
+func (_u *ListEntryUpdate) SetNillableMovieID(v *int) *ListEntryUpdate {
+	if v != nil {
+		_u.SetMovieID(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *ListEntryUpdate) SetPosition(v int) *ListEntryUpdate {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *ListEntryUpdate) SetNillablePosition(v *int) *ListEntryUpdate {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *ListEntryUpdate) AddPosition(v int) *ListEntryUpdate {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetList sets the "list" edge to the List entity.
+func (_u *ListEntryUpdate) SetList(v *List) *ListEntryUpdate {
+	return _u.SetListID(v.ID)
+}
+
+// SetMovie sets the "movie" edge to the Movie entity.
+func (_u *ListEntryUpdate) SetMovie(v *Movie) *ListEntryUpdate {
+	return _u.SetMovieID(v.ID)
+}
+
+// Mutation returns the ListEntryMutation object of the builder.
+func (_u *ListEntryUpdate) Mutation() *ListEntryMutation {
+	return _u.mutation
+}
+
+// ClearList clears the "list" edge to the List entity.
+func (_u *ListEntryUpdate) ClearList() *ListEntryUpdate {
+	_u.mutation.ClearList()
+	return _u
+}
+
+// ClearMovie clears the "movie" edge to the Movie entity.
+func (_u *ListEntryUpdate) ClearMovie() *ListEntryUpdate {
+	_u.mutation.ClearMovie()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ListEntryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ListEntryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ListEntryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ListEntryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ListEntryUpdate) check() error {
+	if v, ok := _u.mutation.Position(); ok {
+		if err := listentry.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "ListEntry.position": %w`, err)}
+		}
+	}
+	if _u.mutation.ListCleared() && len(_u.mutation.ListIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ListEntry.list"`)
+	}
+	if _u.mutation.MovieCleared() && len(_u.mutation.MovieIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ListEntry.movie"`)
+	}
+	return nil
+}
+
+func (_u *ListEntryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(listentry.Table, listentry.Columns, sqlgraph.NewFieldSpec(listentry.FieldListID, field.TypeInt), sqlgraph.NewFieldSpec(listentry.FieldMovieID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(listentry.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(listentry.FieldPosition, field.TypeInt, value)
+	}
+	if _u.mutation.ListCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   listentry.ListTable,
+			Columns: []string{listentry.ListColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(list.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ListIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   listentry.ListTable,
+			Columns: []string{listentry.ListColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(list.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MovieCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   listentry.MovieTable,
+			Columns: []string{listentry.MovieColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(movie.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MovieIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   listentry.MovieTable,
+			Columns: []string{listentry.MovieColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(movie.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{listentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ListEntryUpdateOne is the builder for updating a single ListEntry entity.
+type ListEntryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ListEntryMutation
+}
+
+// SetListID sets the "list_id" field.
+func (_u *ListEntryUpdateOne) SetListID(v int) *ListEntryUpdateOne {
+	_u.mutation.SetListID(v)
+	return _u
+}
+
+// SetNillableListID sets the "list_id" field if the given value is not nil.
+func (_u *ListEntryUpdateOne) SetNillableListID(v *int) *ListEntryUpdateOne {
+	if v != nil {
+		_u.SetListID(*v)
+	}
+	return _u
+}
+
+// SetMovieID sets the "movie_id" field.
+func (_u *ListEntryUpdateOne) SetMovieID(v int) *ListEntryUpdateOne {
+	_u.mutation.SetMovieID(v)
+	return _u
+}
+
+// SetNillableMovieID sets the "movie_id" field if the given value is not nil.
+func (_u *ListEntryUpdateOne) SetNillableMovieID(v *int) *ListEntryUpdateOne {
+	if v != nil {
+		_u.SetMovieID(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *ListEntryUpdateOne) SetPosition(v int) *ListEntryUpdateOne {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *ListEntryUpdateOne) SetNillablePosition(v *int) *ListEntryUpdateOne {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *ListEntryUpdateOne) AddPosition(v int) *ListEntryUpdateOne {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetList sets the "list" edge to the List entity.
+func (_u *ListEntryUpdateOne) SetList(v *List) *ListEntryUpdateOne {
+	return _u.SetListID(v.ID)
+}
+
+// SetMovie sets the "movie" edge to the Movie entity.
+func (_u *ListEntryUpdateOne) SetMovie(v *Movie) *ListEntryUpdateOne {
+	return _u.SetMovieID(v.ID)
+}
+
+// Mutation returns the ListEntryMutation object of the builder.
+func (_u *ListEntryUpdateOne) Mutation() *ListEntryMutation {
+	return _u.mutation
+}
+
+// ClearList clears the "list" edge to the List entity.
+func (_u *ListEntryUpdateOne) ClearList() *ListEntryUpdateOne {
+	_u.mutation.ClearList()
+	return _u
+}
+
+// ClearMovie clears the "movie" edge to the Movie entity.
+func (_u *ListEntryUpdateOne) ClearMovie() *ListEntryUpdateOne {
+	_u.mutation.ClearMovie()
+	return _u
+}
+
+// Where appends a list predicates to the ListEntryUpdate builder.
+func (_u *ListEntryUpdateOne) Where(ps ...predicate.ListEntry) *ListEntryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ListEntryUpdateOne) Select(field string, fields ...string) *ListEntryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ListEntry entity.
+func (_u *ListEntryUpdateOne) Save(ctx context.Context) (*ListEntry, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ListEntryUpdateOne) SaveX(ctx context.Context) *ListEntry {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ListEntryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ListEntryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ListEntryUpdateOne) check() error {
+	if v, ok := _u.mutation.Position(); ok {
+		if err := listentry.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "ListEntry.position": %w`, err)}
+		}
+	}
+	if _u.mutation.ListCleared() && len(_u.mutation.ListIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ListEntry.list"`)
+	}
+	if _u.mutation.MovieCleared() && len(_u.mutation.MovieIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ListEntry.movie"`)
+	}
+	return nil
+}
+
+func (_u *ListEntryUpdateOne) sqlSave(ctx context.Context) (_node *ListEntry, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(listentry.Table, listentry.Columns, sqlgraph.NewFieldSpec(listentry.FieldListID, field.TypeInt), sqlgraph.NewFieldSpec(listentry.FieldMovieID, field.TypeInt))
+	if id, ok := _u.mutation.ListID(); !ok {
+		return nil, &ValidationError{Name: "list_id", err: errors.New(`ent: missing "ListEntry.list_id" for update`)}
+	} else {
+		_spec.Node.CompositeID[0].Value = id
+	}
+	if id, ok := _u.mutation.MovieID(); !ok {
+		return nil, &ValidationError{Name: "movie_id", err: errors.New(`ent: missing "ListEntry.movie_id" for update`)}
+	} else {
+		_spec.Node.CompositeID[1].Value = id
+	}
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, len(fields))
+		for i, f := range fields {
+			if !listentry.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			_spec.Node.Columns[i] = f
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(listentry.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(listentry.FieldPosition, field.TypeInt, value)
+	}
+	if _u.mutation.ListCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   listentry.ListTable,
+			Columns: []string{listentry.ListColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(list.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ListIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   listentry.ListTable,
+			Columns: []string{listentry.ListColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(list.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MovieCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   listentry.MovieTable,
+			Columns: []string{listentry.MovieColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(movie.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MovieIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   listentry.MovieTable,
+			Columns: []string{listentry.MovieColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(movie.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ListEntry{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{listentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -8,9 +8,200 @@ import (
 )
 
 var (
+	// AuditEntriesColumns holds the columns for the "audit_entries" table.
+	AuditEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "movie_id", Type: field.TypeInt},
+		{Name: "actor_id", Type: field.TypeInt, Nullable: true},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"create", "update", "delete", "restore", "purge", "revert"}},
+		{Name: "changes", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AuditEntriesTable holds the schema information for the "audit_entries" table.
+	AuditEntriesTable = &schema.Table{
+		Name:       "audit_entries",
+		Columns:    AuditEntriesColumns,
+		PrimaryKey: []*schema.Column{AuditEntriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditentry_movie_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEntriesColumns[1], AuditEntriesColumns[5]},
+			},
+		},
+	}
+	// AvailabilitiesColumns holds the columns for the "availabilities" table.
+	AvailabilitiesColumns = []*schema.Column{
+		{Name: "available_from", Type: field.TypeTime, Nullable: true},
+		{Name: "available_until", Type: field.TypeTime, Nullable: true},
+		{Name: "movie_id", Type: field.TypeInt},
+		{Name: "platform_id", Type: field.TypeInt},
+	}
+	// AvailabilitiesTable holds the schema information for the "availabilities" table.
+	AvailabilitiesTable = &schema.Table{
+		Name:       "availabilities",
+		Columns:    AvailabilitiesColumns,
+		PrimaryKey: []*schema.Column{AvailabilitiesColumns[2], AvailabilitiesColumns[3]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "availabilities_movies_movie",
+				Columns:    []*schema.Column{AvailabilitiesColumns[2]},
+				RefColumns: []*schema.Column{MoviesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "availabilities_platforms_platform",
+				Columns:    []*schema.Column{AvailabilitiesColumns[3]},
+				RefColumns: []*schema.Column{PlatformsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "availability_platform_id_available_until",
+				Unique:  false,
+				Columns: []*schema.Column{AvailabilitiesColumns[3], AvailabilitiesColumns[1]},
+			},
+		},
+	}
+	// CreditsColumns holds the columns for the "credits" table.
+	CreditsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"director", "actor", "studio"}},
+		{Name: "character", Type: field.TypeString, Nullable: true, Size: 200},
+		{Name: "movie_id", Type: field.TypeInt},
+		{Name: "person_id", Type: field.TypeInt},
+	}
+	// CreditsTable holds the schema information for the "credits" table.
+	CreditsTable = &schema.Table{
+		Name:       "credits",
+		Columns:    CreditsColumns,
+		PrimaryKey: []*schema.Column{CreditsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "credits_movies_credits",
+				Columns:    []*schema.Column{CreditsColumns[3]},
+				RefColumns: []*schema.Column{MoviesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "credits_persons_credits",
+				Columns:    []*schema.Column{CreditsColumns[4]},
+				RefColumns: []*schema.Column{PersonsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "credit_movie_id_person_id_role",
+				Unique:  true,
+				Columns: []*schema.Column{CreditsColumns[3], CreditsColumns[4], CreditsColumns[1]},
+			},
+			{
+				Name:    "credit_person_id",
+				Unique:  false,
+				Columns: []*schema.Column{CreditsColumns[4]},
+			},
+		},
+	}
+	// EpisodesColumns holds the columns for the "episodes" table.
+	EpisodesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "number", Type: field.TypeInt},
+		{Name: "title", Type: field.TypeString, Nullable: true},
+		{Name: "watched_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "season_id", Type: field.TypeInt},
+	}
+	// EpisodesTable holds the schema information for the "episodes" table.
+	EpisodesTable = &schema.Table{
+		Name:       "episodes",
+		Columns:    EpisodesColumns,
+		PrimaryKey: []*schema.Column{EpisodesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "episodes_seasons_episodes",
+				Columns:    []*schema.Column{EpisodesColumns[5]},
+				RefColumns: []*schema.Column{SeasonsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "episode_season_id_number",
+				Unique:  true,
+				Columns: []*schema.Column{EpisodesColumns[5], EpisodesColumns[1]},
+			},
+		},
+	}
+	// ListsColumns holds the columns for the "lists" table.
+	ListsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// ListsTable holds the schema information for the "lists" table.
+	ListsTable = &schema.Table{
+		Name:       "lists",
+		Columns:    ListsColumns,
+		PrimaryKey: []*schema.Column{ListsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "lists_users_lists",
+				Columns:    []*schema.Column{ListsColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "list_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{ListsColumns[5]},
+			},
+		},
+	}
+	// ListEntriesColumns holds the columns for the "list_entries" table.
+	ListEntriesColumns = []*schema.Column{
+		{Name: "position", Type: field.TypeInt},
+		{Name: "added_at", Type: field.TypeTime},
+		{Name: "list_id", Type: field.TypeInt},
+		{Name: "movie_id", Type: field.TypeInt},
+	}
+	// ListEntriesTable holds the schema information for the "list_entries" table.
+	ListEntriesTable = &schema.Table{
+		Name:       "list_entries",
+		Columns:    ListEntriesColumns,
+		PrimaryKey: []*schema.Column{ListEntriesColumns[2], ListEntriesColumns[3]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "list_entries_lists_list",
+				Columns:    []*schema.Column{ListEntriesColumns[2]},
+				RefColumns: []*schema.Column{ListsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "list_entries_movies_movie",
+				Columns:    []*schema.Column{ListEntriesColumns[3]},
+				RefColumns: []*schema.Column{MoviesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "listentry_list_id_position",
+				Unique:  false,
+				Columns: []*schema.Column{ListEntriesColumns[2], ListEntriesColumns[0]},
+			},
+		},
+	}
 	// MoviesColumns holds the columns for the "movies" table.
 	MoviesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "title", Type: field.TypeString},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "genre", Type: field.TypeString, Nullable: true},
@@ -23,45 +214,372 @@ var (
 		{Name: "watched_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "version", Type: field.TypeInt, Default: 1},
+		{Name: "user_id", Type: field.TypeInt, Nullable: true},
 	}
 	// MoviesTable holds the schema information for the "movies" table.
 	MoviesTable = &schema.Table{
 		Name:       "movies",
 		Columns:    MoviesColumns,
 		PrimaryKey: []*schema.Column{MoviesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "movies_users_movies",
+				Columns:    []*schema.Column{MoviesColumns[15]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
 		Indexes: []*schema.Index{
 			{
 				Name:    "movie_title",
 				Unique:  false,
-				Columns: []*schema.Column{MoviesColumns[1]},
+				Columns: []*schema.Column{MoviesColumns[2]},
 			},
 			{
 				Name:    "movie_genre",
 				Unique:  false,
-				Columns: []*schema.Column{MoviesColumns[3]},
+				Columns: []*schema.Column{MoviesColumns[4]},
 			},
 			{
 				Name:    "movie_watch_status",
 				Unique:  false,
-				Columns: []*schema.Column{MoviesColumns[7]},
+				Columns: []*schema.Column{MoviesColumns[8]},
 			},
 			{
 				Name:    "movie_media_type",
 				Unique:  false,
-				Columns: []*schema.Column{MoviesColumns[6]},
+				Columns: []*schema.Column{MoviesColumns[7]},
 			},
 			{
 				Name:    "movie_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{MoviesColumns[11]},
+				Columns: []*schema.Column{MoviesColumns[12]},
+			},
+			{
+				Name:    "movie_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{MoviesColumns[15], MoviesColumns[12]},
+			},
+			{
+				Name:    "movie_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{MoviesColumns[1]},
+			},
+		},
+	}
+	// PersonsColumns holds the columns for the "persons" table.
+	PersonsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Size: 200},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// PersonsTable holds the schema information for the "persons" table.
+	PersonsTable = &schema.Table{
+		Name:       "persons",
+		Columns:    PersonsColumns,
+		PrimaryKey: []*schema.Column{PersonsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "persons_users_people",
+				Columns:    []*schema.Column{PersonsColumns[3]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "person_user_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{PersonsColumns[3], PersonsColumns[1]},
+			},
+		},
+	}
+	// PlatformsColumns holds the columns for the "platforms" table.
+	PlatformsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "slug", Type: field.TypeString, Unique: true, Size: 50},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// PlatformsTable holds the schema information for the "platforms" table.
+	PlatformsTable = &schema.Table{
+		Name:       "platforms",
+		Columns:    PlatformsColumns,
+		PrimaryKey: []*schema.Column{PlatformsColumns[0]},
+	}
+	// RefreshTokensColumns holds the columns for the "refresh_tokens" table.
+	RefreshTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// RefreshTokensTable holds the schema information for the "refresh_tokens" table.
+	RefreshTokensTable = &schema.Table{
+		Name:       "refresh_tokens",
+		Columns:    RefreshTokensColumns,
+		PrimaryKey: []*schema.Column{RefreshTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "refresh_tokens_users_refresh_tokens",
+				Columns:    []*schema.Column{RefreshTokensColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "refreshtoken_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{RefreshTokensColumns[5]},
+			},
+		},
+	}
+	// SeasonsColumns holds the columns for the "seasons" table.
+	SeasonsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "number", Type: field.TypeInt},
+		{Name: "title", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "movie_id", Type: field.TypeInt},
+	}
+	// SeasonsTable holds the schema information for the "seasons" table.
+	SeasonsTable = &schema.Table{
+		Name:       "seasons",
+		Columns:    SeasonsColumns,
+		PrimaryKey: []*schema.Column{SeasonsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "seasons_movies_seasons",
+				Columns:    []*schema.Column{SeasonsColumns[4]},
+				RefColumns: []*schema.Column{MoviesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "season_movie_id_number",
+				Unique:  true,
+				Columns: []*schema.Column{SeasonsColumns[4], SeasonsColumns[1]},
+			},
+		},
+	}
+	// TagsColumns holds the columns for the "tags" table.
+	TagsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Size: 50},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// TagsTable holds the schema information for the "tags" table.
+	TagsTable = &schema.Table{
+		Name:       "tags",
+		Columns:    TagsColumns,
+		PrimaryKey: []*schema.Column{TagsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tags_users_tags",
+				Columns:    []*schema.Column{TagsColumns[3]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tag_user_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{TagsColumns[3], TagsColumns[1]},
+			},
+		},
+	}
+	// UsersColumns holds the columns for the "users" table.
+	UsersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "email", Type: field.TypeString, Unique: true},
+		{Name: "password_hash", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// UsersTable holds the schema information for the "users" table.
+	UsersTable = &schema.Table{
+		Name:       "users",
+		Columns:    UsersColumns,
+		PrimaryKey: []*schema.Column{UsersColumns[0]},
+	}
+	// WatchEventsColumns holds the columns for the "watch_events" table.
+	WatchEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "watched_at", Type: field.TypeTime},
+		{Name: "rating", Type: field.TypeInt, Nullable: true},
+		{Name: "note", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "location", Type: field.TypeString, Nullable: true, Size: 100},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "movie_id", Type: field.TypeInt},
+	}
+	// WatchEventsTable holds the schema information for the "watch_events" table.
+	WatchEventsTable = &schema.Table{
+		Name:       "watch_events",
+		Columns:    WatchEventsColumns,
+		PrimaryKey: []*schema.Column{WatchEventsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "watch_events_movies_watch_events",
+				Columns:    []*schema.Column{WatchEventsColumns[6]},
+				RefColumns: []*schema.Column{MoviesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "watchevent_movie_id_watched_at",
+				Unique:  false,
+				Columns: []*schema.Column{WatchEventsColumns[6], WatchEventsColumns[1]},
+			},
+		},
+	}
+	// WebhooksColumns holds the columns for the "webhooks" table.
+	WebhooksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "url", Type: field.TypeString, Size: 2000},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "events", Type: field.TypeJSON},
+		{Name: "active", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// WebhooksTable holds the schema information for the "webhooks" table.
+	WebhooksTable = &schema.Table{
+		Name:       "webhooks",
+		Columns:    WebhooksColumns,
+		PrimaryKey: []*schema.Column{WebhooksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhooks_users_webhooks",
+				Columns:    []*schema.Column{WebhooksColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhook_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{WebhooksColumns[7]},
+			},
+		},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "event", Type: field.TypeString},
+		{Name: "movie_id", Type: field.TypeInt},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "succeeded", "failed"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeTime, Nullable: true},
+		{Name: "response_status", Type: field.TypeInt, Nullable: true},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "redelivery_of", Type: field.TypeInt, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "webhook_id", Type: field.TypeInt},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_deliveries_webhooks_deliveries",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[12]},
+				RefColumns: []*schema.Column{WebhooksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_webhook_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[12], WebhookDeliveriesColumns[11]},
+			},
+			{
+				Name:    "webhookdelivery_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[4], WebhookDeliveriesColumns[6]},
+			},
+		},
+	}
+	// MovieTagsColumns holds the columns for the "movie_tags" table.
+	MovieTagsColumns = []*schema.Column{
+		{Name: "movie_id", Type: field.TypeInt},
+		{Name: "tag_id", Type: field.TypeInt},
+	}
+	// MovieTagsTable holds the schema information for the "movie_tags" table.
+	MovieTagsTable = &schema.Table{
+		Name:       "movie_tags",
+		Columns:    MovieTagsColumns,
+		PrimaryKey: []*schema.Column{MovieTagsColumns[0], MovieTagsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "movie_tags_movie_id",
+				Columns:    []*schema.Column{MovieTagsColumns[0]},
+				RefColumns: []*schema.Column{MoviesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "movie_tags_tag_id",
+				Columns:    []*schema.Column{MovieTagsColumns[1]},
+				RefColumns: []*schema.Column{TagsColumns[0]},
+				OnDelete:   schema.Cascade,
 			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AuditEntriesTable,
+		AvailabilitiesTable,
+		CreditsTable,
+		EpisodesTable,
+		ListsTable,
+		ListEntriesTable,
 		MoviesTable,
+		PersonsTable,
+		PlatformsTable,
+		RefreshTokensTable,
+		SeasonsTable,
+		TagsTable,
+		UsersTable,
+		WatchEventsTable,
+		WebhooksTable,
+		WebhookDeliveriesTable,
+		MovieTagsTable,
 	}
 )
 
 func init() {
+	AvailabilitiesTable.ForeignKeys[0].RefTable = MoviesTable
+	AvailabilitiesTable.ForeignKeys[1].RefTable = PlatformsTable
+	CreditsTable.ForeignKeys[0].RefTable = MoviesTable
+	CreditsTable.ForeignKeys[1].RefTable = PersonsTable
+	EpisodesTable.ForeignKeys[0].RefTable = SeasonsTable
+	ListsTable.ForeignKeys[0].RefTable = UsersTable
+	ListEntriesTable.ForeignKeys[0].RefTable = ListsTable
+	ListEntriesTable.ForeignKeys[1].RefTable = MoviesTable
+	MoviesTable.ForeignKeys[0].RefTable = UsersTable
+	PersonsTable.ForeignKeys[0].RefTable = UsersTable
+	RefreshTokensTable.ForeignKeys[0].RefTable = UsersTable
+	SeasonsTable.ForeignKeys[0].RefTable = MoviesTable
+	TagsTable.ForeignKeys[0].RefTable = UsersTable
+	WatchEventsTable.ForeignKeys[0].RefTable = MoviesTable
+	WebhooksTable.ForeignKeys[0].RefTable = UsersTable
+	WebhookDeliveriesTable.ForeignKeys[0].RefTable = WebhooksTable
+	MovieTagsTable.ForeignKeys[0].RefTable = MoviesTable
+	MovieTagsTable.ForeignKeys[1].RefTable = TagsTable
 }
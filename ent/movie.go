@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 	"watchlist-app/ent/movie"
+	"watchlist-app/ent/user"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -17,6 +18,8 @@ type Movie struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
+	// 削除日時（ゴミ箱に入っている場合に設定）
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// 映画・ドラマのタイトル
 	Title string `json:"title,omitempty"`
 	// 概要・あらすじ
@@ -40,20 +43,135 @@ type Movie struct {
 	// 作成日時
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// 更新日時
-	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// バージョン
+	Version int `json:"version,omitempty"`
+	// 所有ユーザーID
+	UserID int `json:"user_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MovieQuery when eager-loading is set.
+	Edges        MovieEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// MovieEdges holds the relations/edges for other nodes in the graph.
+type MovieEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
+	// Seasons holds the value of the seasons edge.
+	Seasons []*Season `json:"seasons,omitempty"`
+	// Tags holds the value of the tags edge.
+	Tags []*Tag `json:"tags,omitempty"`
+	// WatchEvents holds the value of the watch_events edge.
+	WatchEvents []*WatchEvent `json:"watch_events,omitempty"`
+	// Lists holds the value of the lists edge.
+	Lists []*List `json:"lists,omitempty"`
+	// Credits holds the value of the credits edge.
+	Credits []*Credit `json:"credits,omitempty"`
+	// Platforms holds the value of the platforms edge.
+	Platforms []*Platform `json:"platforms,omitempty"`
+	// ListEntries holds the value of the list_entries edge.
+	ListEntries []*ListEntry `json:"list_entries,omitempty"`
+	// Availabilities holds the value of the availabilities edge.
+	Availabilities []*Availability `json:"availabilities,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [9]bool
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MovieEdges) OwnerOrErr() (*User, error) {
+	if e.Owner != nil {
+		return e.Owner, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// SeasonsOrErr returns the Seasons value or an error if the edge
+// was not loaded in eager-loading.
+func (e MovieEdges) SeasonsOrErr() ([]*Season, error) {
+	if e.loadedTypes[1] {
+		return e.Seasons, nil
+	}
+	return nil, &NotLoadedError{edge: "seasons"}
+}
+
+// TagsOrErr returns the Tags value or an error if the edge
+// was not loaded in eager-loading.
+func (e MovieEdges) TagsOrErr() ([]*Tag, error) {
+	if e.loadedTypes[2] {
+		return e.Tags, nil
+	}
+	return nil, &NotLoadedError{edge: "tags"}
+}
+
+// WatchEventsOrErr returns the WatchEvents value or an error if the edge
+// was not loaded in eager-loading.
+func (e MovieEdges) WatchEventsOrErr() ([]*WatchEvent, error) {
+	if e.loadedTypes[3] {
+		return e.WatchEvents, nil
+	}
+	return nil, &NotLoadedError{edge: "watch_events"}
+}
+
+// ListsOrErr returns the Lists value or an error if the edge
+// was not loaded in eager-loading.
+func (e MovieEdges) ListsOrErr() ([]*List, error) {
+	if e.loadedTypes[4] {
+		return e.Lists, nil
+	}
+	return nil, &NotLoadedError{edge: "lists"}
+}
+
+// CreditsOrErr returns the Credits value or an error if the edge
+// was not loaded in eager-loading.
+func (e MovieEdges) CreditsOrErr() ([]*Credit, error) {
+	if e.loadedTypes[5] {
+		return e.Credits, nil
+	}
+	return nil, &NotLoadedError{edge: "credits"}
+}
+
+// PlatformsOrErr returns the Platforms value or an error if the edge
+// was not loaded in eager-loading.
+func (e MovieEdges) PlatformsOrErr() ([]*Platform, error) {
+	if e.loadedTypes[6] {
+		return e.Platforms, nil
+	}
+	return nil, &NotLoadedError{edge: "platforms"}
+}
+
+// ListEntriesOrErr returns the ListEntries value or an error if the edge
+// was not loaded in eager-loading.
+func (e MovieEdges) ListEntriesOrErr() ([]*ListEntry, error) {
+	if e.loadedTypes[7] {
+		return e.ListEntries, nil
+	}
+	return nil, &NotLoadedError{edge: "list_entries"}
+}
+
+// AvailabilitiesOrErr returns the Availabilities value or an error if the edge
+// was not loaded in eager-loading.
+func (e MovieEdges) AvailabilitiesOrErr() ([]*Availability, error) {
+	if e.loadedTypes[8] {
+		return e.Availabilities, nil
+	}
+	return nil, &NotLoadedError{edge: "availabilities"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Movie) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case movie.FieldID, movie.FieldReleaseYear, movie.FieldRating:
+		case movie.FieldID, movie.FieldReleaseYear, movie.FieldRating, movie.FieldVersion, movie.FieldUserID:
 			values[i] = new(sql.NullInt64)
 		case movie.FieldTitle, movie.FieldDescription, movie.FieldGenre, movie.FieldPosterURL, movie.FieldMediaType, movie.FieldWatchStatus, movie.FieldReview:
 			values[i] = new(sql.NullString)
-		case movie.FieldWatchedAt, movie.FieldCreatedAt, movie.FieldUpdatedAt:
+		case movie.FieldDeletedAt, movie.FieldWatchedAt, movie.FieldCreatedAt, movie.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -76,6 +194,13 @@ func (_m *Movie) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			_m.ID = int(value.Int64)
+		case movie.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
 		case movie.FieldTitle:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field title", values[i])
@@ -148,6 +273,18 @@ func (_m *Movie) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UpdatedAt = value.Time
 			}
+		case movie.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = int(value.Int64)
+			}
+		case movie.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = int(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -161,6 +298,51 @@ func (_m *Movie) Value(name string) (ent.Value, error) {
 	return _m.selectValues.Get(name)
 }
 
+// QueryOwner queries the "owner" edge of the Movie entity.
+func (_m *Movie) QueryOwner() *UserQuery {
+	return NewMovieClient(_m.config).QueryOwner(_m)
+}
+
+// QuerySeasons queries the "seasons" edge of the Movie entity.
+func (_m *Movie) QuerySeasons() *SeasonQuery {
+	return NewMovieClient(_m.config).QuerySeasons(_m)
+}
+
+// QueryTags queries the "tags" edge of the Movie entity.
+func (_m *Movie) QueryTags() *TagQuery {
+	return NewMovieClient(_m.config).QueryTags(_m)
+}
+
+// QueryWatchEvents queries the "watch_events" edge of the Movie entity.
+func (_m *Movie) QueryWatchEvents() *WatchEventQuery {
+	return NewMovieClient(_m.config).QueryWatchEvents(_m)
+}
+
+// QueryLists queries the "lists" edge of the Movie entity.
+func (_m *Movie) QueryLists() *ListQuery {
+	return NewMovieClient(_m.config).QueryLists(_m)
+}
+
+// QueryCredits queries the "credits" edge of the Movie entity.
+func (_m *Movie) QueryCredits() *CreditQuery {
+	return NewMovieClient(_m.config).QueryCredits(_m)
+}
+
+// QueryPlatforms queries the "platforms" edge of the Movie entity.
+func (_m *Movie) QueryPlatforms() *PlatformQuery {
+	return NewMovieClient(_m.config).QueryPlatforms(_m)
+}
+
+// QueryListEntries queries the "list_entries" edge of the Movie entity.
+func (_m *Movie) QueryListEntries() *ListEntryQuery {
+	return NewMovieClient(_m.config).QueryListEntries(_m)
+}
+
+// QueryAvailabilities queries the "availabilities" edge of the Movie entity.
+func (_m *Movie) QueryAvailabilities() *AvailabilityQuery {
+	return NewMovieClient(_m.config).QueryAvailabilities(_m)
+}
+
 // Update returns a builder for updating this Movie.
 // Note that you need to call Movie.Unwrap() before calling this method if this Movie
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -184,6 +366,11 @@ func (_m *Movie) String() string {
 	var builder strings.Builder
 	builder.WriteString("Movie(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("title=")
 	builder.WriteString(_m.Title)
 	builder.WriteString(", ")
@@ -219,6 +406,12 @@ func (_m *Movie) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
 	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Version))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
 	builder.WriteByte(')')
 	return builder.String()
 }
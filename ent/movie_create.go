@@ -7,7 +7,14 @@ import (
 	"errors"
 	"fmt"
 	"time"
+	"watchlist-app/ent/credit"
+	"watchlist-app/ent/list"
 	"watchlist-app/ent/movie"
+	"watchlist-app/ent/platform"
+	"watchlist-app/ent/season"
+	"watchlist-app/ent/tag"
+	"watchlist-app/ent/user"
+	"watchlist-app/ent/watchevent"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -20,6 +27,20 @@ type MovieCreate struct {
 	hooks    []Hook
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *MovieCreate) SetDeletedAt(v time.Time) *MovieCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *MovieCreate) SetNillableDeletedAt(v *time.Time) *MovieCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
 // SetTitle sets the "title" field.
 func (_c *MovieCreate) SetTitle(v string) *MovieCreate {
 	_c.mutation.SetTitle(v)
@@ -180,6 +201,143 @@ func (_c *MovieCreate) SetNillableUpdatedAt(v *time.Time) *MovieCreate {
 	return _c
 }
 
+// SetVersion sets the "version" field.
+func (_c *MovieCreate) SetVersion(v int) *MovieCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_c *MovieCreate) SetNillableVersion(v *int) *MovieCreate {
+	if v != nil {
+		_c.SetVersion(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *MovieCreate) SetUserID(v int) *MovieCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *MovieCreate) SetNillableUserID(v *int) *MovieCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (_c *MovieCreate) SetOwnerID(id int) *MovieCreate {
+	_c.mutation.SetOwnerID(id)
+	return _c
+}
+
+// SetNillableOwnerID sets the "owner" edge to the User entity by ID if the given value is not nil.
+func (_c *MovieCreate) SetNillableOwnerID(id *int) *MovieCreate {
+	if id != nil {
+		_c = _c.SetOwnerID(*id)
+	}
+	return _c
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (_c *MovieCreate) SetOwner(v *User) *MovieCreate {
+	return _c.SetOwnerID(v.ID)
+}
+
+// AddSeasonIDs adds the "seasons" edge to the Season entity by IDs.
+func (_c *MovieCreate) AddSeasonIDs(ids ...int) *MovieCreate {
+	_c.mutation.AddSeasonIDs(ids...)
+	return _c
+}
+
+// AddSeasons adds the "seasons" edges to the Season entity.
+func (_c *MovieCreate) AddSeasons(v ...*Season) *MovieCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSeasonIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (_c *MovieCreate) AddTagIDs(ids ...int) *MovieCreate {
+	_c.mutation.AddTagIDs(ids...)
+	return _c
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (_c *MovieCreate) AddTags(v ...*Tag) *MovieCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTagIDs(ids...)
+}
+
+// AddWatchEventIDs adds the "watch_events" edge to the WatchEvent entity by IDs.
+func (_c *MovieCreate) AddWatchEventIDs(ids ...int) *MovieCreate {
+	_c.mutation.AddWatchEventIDs(ids...)
+	return _c
+}
+
+// AddWatchEvents adds the "watch_events" edges to the WatchEvent entity.
+func (_c *MovieCreate) AddWatchEvents(v ...*WatchEvent) *MovieCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddWatchEventIDs(ids...)
+}
+
+// AddListIDs adds the "lists" edge to the List entity by IDs.
+func (_c *MovieCreate) AddListIDs(ids ...int) *MovieCreate {
+	_c.mutation.AddListIDs(ids...)
+	return _c
+}
+
+// AddLists adds the "lists" edges to the List entity.
+func (_c *MovieCreate) AddLists(v ...*List) *MovieCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddListIDs(ids...)
+}
+
+// AddCreditIDs adds the "credits" edge to the Credit entity by IDs.
+func (_c *MovieCreate) AddCreditIDs(ids ...int) *MovieCreate {
+	_c.mutation.AddCreditIDs(ids...)
+	return _c
+}
+
+// AddCredits adds the "credits" edges to the Credit entity.
+func (_c *MovieCreate) AddCredits(v ...*Credit) *MovieCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddCreditIDs(ids...)
+}
+
+// AddPlatformIDs adds the "platforms" edge to the Platform entity by IDs.
+func (_c *MovieCreate) AddPlatformIDs(ids ...int) *MovieCreate {
+	_c.mutation.AddPlatformIDs(ids...)
+	return _c
+}
+
+// AddPlatforms adds the "platforms" edges to the Platform entity.
+func (_c *MovieCreate) AddPlatforms(v ...*Platform) *MovieCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPlatformIDs(ids...)
+}
+
 // Mutation returns the MovieMutation object of the builder.
 func (_c *MovieCreate) Mutation() *MovieMutation {
 	return _c.mutation
@@ -187,7 +345,9 @@ func (_c *MovieCreate) Mutation() *MovieMutation {
 
 // Save creates the Movie in the database.
 func (_c *MovieCreate) Save(ctx context.Context) (*Movie, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -214,7 +374,7 @@ func (_c *MovieCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *MovieCreate) defaults() {
+func (_c *MovieCreate) defaults() error {
 	if _, ok := _c.mutation.MediaType(); !ok {
 		v := movie.DefaultMediaType
 		_c.mutation.SetMediaType(v)
@@ -224,13 +384,24 @@ func (_c *MovieCreate) defaults() {
 		_c.mutation.SetWatchStatus(v)
 	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if movie.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized movie.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := movie.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if movie.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized movie.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := movie.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := _c.mutation.Version(); !ok {
+		v := movie.DefaultVersion
+		_c.mutation.SetVersion(v)
+	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -270,6 +441,9 @@ func (_c *MovieCreate) check() error {
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Movie.updated_at"`)}
 	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Movie.version"`)}
+	}
 	return nil
 }
 
@@ -296,6 +470,10 @@ func (_c *MovieCreate) createSpec() (*Movie, *sqlgraph.CreateSpec) {
 		_node = &Movie{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(movie.Table, sqlgraph.NewFieldSpec(movie.FieldID, field.TypeInt))
 	)
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(movie.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if value, ok := _c.mutation.Title(); ok {
 		_spec.SetField(movie.FieldTitle, field.TypeString, value)
 		_node.Title = value
@@ -344,6 +522,127 @@ func (_c *MovieCreate) createSpec() (*Movie, *sqlgraph.CreateSpec) {
 		_spec.SetField(movie.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(movie.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if nodes := _c.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   movie.OwnerTable,
+			Columns: []string{movie.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SeasonsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   movie.SeasonsTable,
+			Columns: []string{movie.SeasonsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(season.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   movie.TagsTable,
+			Columns: movie.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.WatchEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   movie.WatchEventsTable,
+			Columns: []string{movie.WatchEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(watchevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ListsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   movie.ListsTable,
+			Columns: movie.ListsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(list.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &ListEntryCreate{config: _c.config, mutation: newListEntryMutation(_c.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CreditsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   movie.CreditsTable,
+			Columns: []string{movie.CreditsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(credit.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PlatformsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   movie.PlatformsTable,
+			Columns: movie.PlatformsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(platform.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
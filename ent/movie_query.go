@@ -4,10 +4,20 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
+	"watchlist-app/ent/availability"
+	"watchlist-app/ent/credit"
+	"watchlist-app/ent/list"
+	"watchlist-app/ent/listentry"
 	"watchlist-app/ent/movie"
+	"watchlist-app/ent/platform"
 	"watchlist-app/ent/predicate"
+	"watchlist-app/ent/season"
+	"watchlist-app/ent/tag"
+	"watchlist-app/ent/user"
+	"watchlist-app/ent/watchevent"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -18,10 +28,19 @@ import (
 // MovieQuery is the builder for querying Movie entities.
 type MovieQuery struct {
 	config
-	ctx        *QueryContext
-	order      []movie.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Movie
+	ctx                *QueryContext
+	order              []movie.OrderOption
+	inters             []Interceptor
+	predicates         []predicate.Movie
+	withOwner          *UserQuery
+	withSeasons        *SeasonQuery
+	withTags           *TagQuery
+	withWatchEvents    *WatchEventQuery
+	withLists          *ListQuery
+	withCredits        *CreditQuery
+	withPlatforms      *PlatformQuery
+	withListEntries    *ListEntryQuery
+	withAvailabilities *AvailabilityQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -58,6 +77,204 @@ func (_q *MovieQuery) Order(o ...movie.OrderOption) *MovieQuery {
 	return _q
 }
 
+// QueryOwner chains the current query on the "owner" edge.
+func (_q *MovieQuery) QueryOwner() *UserQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(movie.Table, movie.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, movie.OwnerTable, movie.OwnerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySeasons chains the current query on the "seasons" edge.
+func (_q *MovieQuery) QuerySeasons() *SeasonQuery {
+	query := (&SeasonClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(movie.Table, movie.FieldID, selector),
+			sqlgraph.To(season.Table, season.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, movie.SeasonsTable, movie.SeasonsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTags chains the current query on the "tags" edge.
+func (_q *MovieQuery) QueryTags() *TagQuery {
+	query := (&TagClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(movie.Table, movie.FieldID, selector),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, movie.TagsTable, movie.TagsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWatchEvents chains the current query on the "watch_events" edge.
+func (_q *MovieQuery) QueryWatchEvents() *WatchEventQuery {
+	query := (&WatchEventClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(movie.Table, movie.FieldID, selector),
+			sqlgraph.To(watchevent.Table, watchevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, movie.WatchEventsTable, movie.WatchEventsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLists chains the current query on the "lists" edge.
+func (_q *MovieQuery) QueryLists() *ListQuery {
+	query := (&ListClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(movie.Table, movie.FieldID, selector),
+			sqlgraph.To(list.Table, list.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, movie.ListsTable, movie.ListsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCredits chains the current query on the "credits" edge.
+func (_q *MovieQuery) QueryCredits() *CreditQuery {
+	query := (&CreditClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(movie.Table, movie.FieldID, selector),
+			sqlgraph.To(credit.Table, credit.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, movie.CreditsTable, movie.CreditsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPlatforms chains the current query on the "platforms" edge.
+func (_q *MovieQuery) QueryPlatforms() *PlatformQuery {
+	query := (&PlatformClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(movie.Table, movie.FieldID, selector),
+			sqlgraph.To(platform.Table, platform.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, movie.PlatformsTable, movie.PlatformsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryListEntries chains the current query on the "list_entries" edge.
+func (_q *MovieQuery) QueryListEntries() *ListEntryQuery {
+	query := (&ListEntryClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(movie.Table, movie.FieldID, selector),
+			sqlgraph.To(listentry.Table, listentry.MovieColumn),
+			sqlgraph.Edge(sqlgraph.O2M, true, movie.ListEntriesTable, movie.ListEntriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAvailabilities chains the current query on the "availabilities" edge.
+func (_q *MovieQuery) QueryAvailabilities() *AvailabilityQuery {
+	query := (&AvailabilityClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(movie.Table, movie.FieldID, selector),
+			sqlgraph.To(availability.Table, availability.MovieColumn),
+			sqlgraph.Edge(sqlgraph.O2M, true, movie.AvailabilitiesTable, movie.AvailabilitiesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Movie entity from the query.
 // Returns a *NotFoundError when no Movie was found.
 func (_q *MovieQuery) First(ctx context.Context) (*Movie, error) {
@@ -245,29 +462,137 @@ func (_q *MovieQuery) Clone() *MovieQuery {
 		return nil
 	}
 	return &MovieQuery{
-		config:     _q.config,
-		ctx:        _q.ctx.Clone(),
-		order:      append([]movie.OrderOption{}, _q.order...),
-		inters:     append([]Interceptor{}, _q.inters...),
-		predicates: append([]predicate.Movie{}, _q.predicates...),
+		config:             _q.config,
+		ctx:                _q.ctx.Clone(),
+		order:              append([]movie.OrderOption{}, _q.order...),
+		inters:             append([]Interceptor{}, _q.inters...),
+		predicates:         append([]predicate.Movie{}, _q.predicates...),
+		withOwner:          _q.withOwner.Clone(),
+		withSeasons:        _q.withSeasons.Clone(),
+		withTags:           _q.withTags.Clone(),
+		withWatchEvents:    _q.withWatchEvents.Clone(),
+		withLists:          _q.withLists.Clone(),
+		withCredits:        _q.withCredits.Clone(),
+		withPlatforms:      _q.withPlatforms.Clone(),
+		withListEntries:    _q.withListEntries.Clone(),
+		withAvailabilities: _q.withAvailabilities.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
 	}
 }
 
+// WithOwner tells the query-builder to eager-load the nodes that are connected to
+// the "owner" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *MovieQuery) WithOwner(opts ...func(*UserQuery)) *MovieQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withOwner = query
+	return _q
+}
+
+// WithSeasons tells the query-builder to eager-load the nodes that are connected to
+// the "seasons" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *MovieQuery) WithSeasons(opts ...func(*SeasonQuery)) *MovieQuery {
+	query := (&SeasonClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSeasons = query
+	return _q
+}
+
+// WithTags tells the query-builder to eager-load the nodes that are connected to
+// the "tags" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *MovieQuery) WithTags(opts ...func(*TagQuery)) *MovieQuery {
+	query := (&TagClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTags = query
+	return _q
+}
+
+// WithWatchEvents tells the query-builder to eager-load the nodes that are connected to
+// the "watch_events" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *MovieQuery) WithWatchEvents(opts ...func(*WatchEventQuery)) *MovieQuery {
+	query := (&WatchEventClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withWatchEvents = query
+	return _q
+}
+
+// WithLists tells the query-builder to eager-load the nodes that are connected to
+// the "lists" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *MovieQuery) WithLists(opts ...func(*ListQuery)) *MovieQuery {
+	query := (&ListClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLists = query
+	return _q
+}
+
+// WithCredits tells the query-builder to eager-load the nodes that are connected to
+// the "credits" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *MovieQuery) WithCredits(opts ...func(*CreditQuery)) *MovieQuery {
+	query := (&CreditClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withCredits = query
+	return _q
+}
+
+// WithPlatforms tells the query-builder to eager-load the nodes that are connected to
+// the "platforms" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *MovieQuery) WithPlatforms(opts ...func(*PlatformQuery)) *MovieQuery {
+	query := (&PlatformClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPlatforms = query
+	return _q
+}
+
+// WithListEntries tells the query-builder to eager-load the nodes that are connected to
+// the "list_entries" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *MovieQuery) WithListEntries(opts ...func(*ListEntryQuery)) *MovieQuery {
+	query := (&ListEntryClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withListEntries = query
+	return _q
+}
+
+// WithAvailabilities tells the query-builder to eager-load the nodes that are connected to
+// the "availabilities" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *MovieQuery) WithAvailabilities(opts ...func(*AvailabilityQuery)) *MovieQuery {
+	query := (&AvailabilityClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAvailabilities = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
 // Example:
 //
 //	var v []struct {
-//		Title string `json:"title,omitempty"`
+//		DeletedAt time.Time `json:"deleted_at,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Movie.Query().
-//		GroupBy(movie.FieldTitle).
+//		GroupBy(movie.FieldDeletedAt).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *MovieQuery) GroupBy(field string, fields ...string) *MovieGroupBy {
@@ -285,11 +610,11 @@ func (_q *MovieQuery) GroupBy(field string, fields ...string) *MovieGroupBy {
 // Example:
 //
 //	var v []struct {
-//		Title string `json:"title,omitempty"`
+//		DeletedAt time.Time `json:"deleted_at,omitempty"`
 //	}
 //
 //	client.Movie.Query().
-//		Select(movie.FieldTitle).
+//		Select(movie.FieldDeletedAt).
 //		Scan(ctx, &v)
 func (_q *MovieQuery) Select(fields ...string) *MovieSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
@@ -332,8 +657,19 @@ func (_q *MovieQuery) prepareQuery(ctx context.Context) error {
 
 func (_q *MovieQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Movie, error) {
 	var (
-		nodes = []*Movie{}
-		_spec = _q.querySpec()
+		nodes       = []*Movie{}
+		_spec       = _q.querySpec()
+		loadedTypes = [9]bool{
+			_q.withOwner != nil,
+			_q.withSeasons != nil,
+			_q.withTags != nil,
+			_q.withWatchEvents != nil,
+			_q.withLists != nil,
+			_q.withCredits != nil,
+			_q.withPlatforms != nil,
+			_q.withListEntries != nil,
+			_q.withAvailabilities != nil,
+		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*Movie).scanValues(nil, columns)
@@ -341,6 +677,7 @@ func (_q *MovieQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Movie,
 	_spec.Assign = func(columns []string, values []any) error {
 		node := &Movie{config: _q.config}
 		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
 	for i := range hooks {
@@ -352,9 +689,434 @@ func (_q *MovieQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Movie,
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := _q.withOwner; query != nil {
+		if err := _q.loadOwner(ctx, query, nodes, nil,
+			func(n *Movie, e *User) { n.Edges.Owner = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withSeasons; query != nil {
+		if err := _q.loadSeasons(ctx, query, nodes,
+			func(n *Movie) { n.Edges.Seasons = []*Season{} },
+			func(n *Movie, e *Season) { n.Edges.Seasons = append(n.Edges.Seasons, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTags; query != nil {
+		if err := _q.loadTags(ctx, query, nodes,
+			func(n *Movie) { n.Edges.Tags = []*Tag{} },
+			func(n *Movie, e *Tag) { n.Edges.Tags = append(n.Edges.Tags, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withWatchEvents; query != nil {
+		if err := _q.loadWatchEvents(ctx, query, nodes,
+			func(n *Movie) { n.Edges.WatchEvents = []*WatchEvent{} },
+			func(n *Movie, e *WatchEvent) { n.Edges.WatchEvents = append(n.Edges.WatchEvents, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withLists; query != nil {
+		if err := _q.loadLists(ctx, query, nodes,
+			func(n *Movie) { n.Edges.Lists = []*List{} },
+			func(n *Movie, e *List) { n.Edges.Lists = append(n.Edges.Lists, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withCredits; query != nil {
+		if err := _q.loadCredits(ctx, query, nodes,
+			func(n *Movie) { n.Edges.Credits = []*Credit{} },
+			func(n *Movie, e *Credit) { n.Edges.Credits = append(n.Edges.Credits, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPlatforms; query != nil {
+		if err := _q.loadPlatforms(ctx, query, nodes,
+			func(n *Movie) { n.Edges.Platforms = []*Platform{} },
+			func(n *Movie, e *Platform) { n.Edges.Platforms = append(n.Edges.Platforms, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withListEntries; query != nil {
+		if err := _q.loadListEntries(ctx, query, nodes,
+			func(n *Movie) { n.Edges.ListEntries = []*ListEntry{} },
+			func(n *Movie, e *ListEntry) { n.Edges.ListEntries = append(n.Edges.ListEntries, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withAvailabilities; query != nil {
+		if err := _q.loadAvailabilities(ctx, query, nodes,
+			func(n *Movie) { n.Edges.Availabilities = []*Availability{} },
+			func(n *Movie, e *Availability) { n.Edges.Availabilities = append(n.Edges.Availabilities, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
+func (_q *MovieQuery) loadOwner(ctx context.Context, query *UserQuery, nodes []*Movie, init func(*Movie), assign func(*Movie, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Movie)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *MovieQuery) loadSeasons(ctx context.Context, query *SeasonQuery, nodes []*Movie, init func(*Movie), assign func(*Movie, *Season)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Movie)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(season.FieldMovieID)
+	}
+	query.Where(predicate.Season(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(movie.SeasonsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MovieID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "movie_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *MovieQuery) loadTags(ctx context.Context, query *TagQuery, nodes []*Movie, init func(*Movie), assign func(*Movie, *Tag)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Movie)
+	nids := make(map[int]map[*Movie]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(movie.TagsTable)
+		s.Join(joinT).On(s.C(tag.FieldID), joinT.C(movie.TagsPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(movie.TagsPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(movie.TagsPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Movie]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Tag](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "tags" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (_q *MovieQuery) loadWatchEvents(ctx context.Context, query *WatchEventQuery, nodes []*Movie, init func(*Movie), assign func(*Movie, *WatchEvent)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Movie)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(watchevent.FieldMovieID)
+	}
+	query.Where(predicate.WatchEvent(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(movie.WatchEventsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MovieID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "movie_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *MovieQuery) loadLists(ctx context.Context, query *ListQuery, nodes []*Movie, init func(*Movie), assign func(*Movie, *List)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Movie)
+	nids := make(map[int]map[*Movie]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(movie.ListsTable)
+		s.Join(joinT).On(s.C(list.FieldID), joinT.C(movie.ListsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(movie.ListsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(movie.ListsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Movie]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*List](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "lists" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (_q *MovieQuery) loadCredits(ctx context.Context, query *CreditQuery, nodes []*Movie, init func(*Movie), assign func(*Movie, *Credit)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Movie)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(credit.FieldMovieID)
+	}
+	query.Where(predicate.Credit(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(movie.CreditsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MovieID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "movie_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *MovieQuery) loadPlatforms(ctx context.Context, query *PlatformQuery, nodes []*Movie, init func(*Movie), assign func(*Movie, *Platform)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Movie)
+	nids := make(map[int]map[*Movie]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(movie.PlatformsTable)
+		s.Join(joinT).On(s.C(platform.FieldID), joinT.C(movie.PlatformsPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(movie.PlatformsPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(movie.PlatformsPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Movie]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Platform](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "platforms" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (_q *MovieQuery) loadListEntries(ctx context.Context, query *ListEntryQuery, nodes []*Movie, init func(*Movie), assign func(*Movie, *ListEntry)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Movie)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(listentry.FieldMovieID)
+	}
+	query.Where(predicate.ListEntry(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(movie.ListEntriesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MovieID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "movie_id" returned %v for node %v`, fk, n)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *MovieQuery) loadAvailabilities(ctx context.Context, query *AvailabilityQuery, nodes []*Movie, init func(*Movie), assign func(*Movie, *Availability)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Movie)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(availability.FieldMovieID)
+	}
+	query.Where(predicate.Availability(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(movie.AvailabilitiesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MovieID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "movie_id" returned %v for node %v`, fk, n)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
 func (_q *MovieQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
 	_spec.Node.Columns = _q.ctx.Fields
@@ -380,6 +1142,9 @@ func (_q *MovieQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if _q.withOwner != nil {
+			_spec.Node.AddColumnOnce(movie.FieldUserID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -0,0 +1,170 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"watchlist-app/ent/platform"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Platform is the model entity for the Platform schema.
+type Platform struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 識別子（netflix, prime_video など。絞り込みに使用）
+	Slug string `json:"slug,omitempty"`
+	// 表示名
+	Name string `json:"name,omitempty"`
+	// 作成日時
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PlatformQuery when eager-loading is set.
+	Edges        PlatformEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PlatformEdges holds the relations/edges for other nodes in the graph.
+type PlatformEdges struct {
+	// Movies holds the value of the movies edge.
+	Movies []*Movie `json:"movies,omitempty"`
+	// Availabilities holds the value of the availabilities edge.
+	Availabilities []*Availability `json:"availabilities,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// MoviesOrErr returns the Movies value or an error if the edge
+// was not loaded in eager-loading.
+func (e PlatformEdges) MoviesOrErr() ([]*Movie, error) {
+	if e.loadedTypes[0] {
+		return e.Movies, nil
+	}
+	return nil, &NotLoadedError{edge: "movies"}
+}
+
+// AvailabilitiesOrErr returns the Availabilities value or an error if the edge
+// was not loaded in eager-loading.
+func (e PlatformEdges) AvailabilitiesOrErr() ([]*Availability, error) {
+	if e.loadedTypes[1] {
+		return e.Availabilities, nil
+	}
+	return nil, &NotLoadedError{edge: "availabilities"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Platform) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case platform.FieldID:
+			values[i] = new(sql.NullInt64)
+		case platform.FieldSlug, platform.FieldName:
+			values[i] = new(sql.NullString)
+		case platform.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Platform fields.
+func (_m *Platform) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case platform.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case platform.FieldSlug:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field slug", values[i])
+			} else if value.Valid {
+				_m.Slug = value.String
+			}
+		case platform.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case platform.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Platform.
+// This includes values selected through modifiers, order, etc.
+func (_m *Platform) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryMovies queries the "movies" edge of the Platform entity.
+func (_m *Platform) QueryMovies() *MovieQuery {
+	return NewPlatformClient(_m.config).QueryMovies(_m)
+}
+
+// QueryAvailabilities queries the "availabilities" edge of the Platform entity.
+func (_m *Platform) QueryAvailabilities() *AvailabilityQuery {
+	return NewPlatformClient(_m.config).QueryAvailabilities(_m)
+}
+
+// Update returns a builder for updating this Platform.
+// Note that you need to call Platform.Unwrap() before calling this method if this Platform
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Platform) Update() *PlatformUpdateOne {
+	return NewPlatformClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Platform entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Platform) Unwrap() *Platform {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Platform is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Platform) String() string {
+	var builder strings.Builder
+	builder.WriteString("Platform(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("slug=")
+	builder.WriteString(_m.Slug)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Platforms is a parsable slice of Platform.
+type Platforms []*Platform
@@ -0,0 +1,139 @@
+// Code generated by ent, DO NOT EDIT.
+
+package platform
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the platform type in the database.
+	Label = "platform"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSlug holds the string denoting the slug field in the database.
+	FieldSlug = "slug"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeMovies holds the string denoting the movies edge name in mutations.
+	EdgeMovies = "movies"
+	// EdgeAvailabilities holds the string denoting the availabilities edge name in mutations.
+	EdgeAvailabilities = "availabilities"
+	// Table holds the table name of the platform in the database.
+	Table = "platforms"
+	// MoviesTable is the table that holds the movies relation/edge. The primary key declared below.
+	MoviesTable = "availabilities"
+	// MoviesInverseTable is the table name for the Movie entity.
+	// It exists in this package in order to avoid circular dependency with the "movie" package.
+	MoviesInverseTable = "movies"
+	// AvailabilitiesTable is the table that holds the availabilities relation/edge.
+	AvailabilitiesTable = "availabilities"
+	// AvailabilitiesInverseTable is the table name for the Availability entity.
+	// It exists in this package in order to avoid circular dependency with the "availability" package.
+	AvailabilitiesInverseTable = "availabilities"
+	// AvailabilitiesColumn is the table column denoting the availabilities relation/edge.
+	AvailabilitiesColumn = "platform_id"
+)
+
+// Columns holds all SQL columns for platform fields.
+var Columns = []string{
+	FieldID,
+	FieldSlug,
+	FieldName,
+	FieldCreatedAt,
+}
+
+var (
+	// MoviesPrimaryKey and MoviesColumn2 are the table columns denoting the
+	// primary key for the movies relation (M2M).
+	MoviesPrimaryKey = []string{"movie_id", "platform_id"}
+)
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	SlugValidator func(string) error
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the Platform queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// BySlug orders the results by the slug field.
+func BySlug(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSlug, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByMoviesCount orders the results by movies count.
+func ByMoviesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMoviesStep(), opts...)
+	}
+}
+
+// ByMovies orders the results by movies terms.
+func ByMovies(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMoviesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAvailabilitiesCount orders the results by availabilities count.
+func ByAvailabilitiesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAvailabilitiesStep(), opts...)
+	}
+}
+
+// ByAvailabilities orders the results by availabilities terms.
+func ByAvailabilities(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAvailabilitiesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newMoviesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MoviesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, MoviesTable, MoviesPrimaryKey...),
+	)
+}
+func newAvailabilitiesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AvailabilitiesInverseTable, AvailabilitiesColumn),
+		sqlgraph.Edge(sqlgraph.O2M, true, AvailabilitiesTable, AvailabilitiesColumn),
+	)
+}
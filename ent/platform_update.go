@@ -0,0 +1,442 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"watchlist-app/ent/movie"
+	"watchlist-app/ent/platform"
+	"watchlist-app/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PlatformUpdate is the builder for updating Platform entities.
+type PlatformUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PlatformMutation
+}
+
+// Where appends a list predicates to the PlatformUpdate builder.
+func (_u *PlatformUpdate) Where(ps ...predicate.Platform) *PlatformUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetSlug sets the "slug" field.
+func (_u *PlatformUpdate) SetSlug(v string) *PlatformUpdate {
+	_u.mutation.SetSlug(v)
+	return _u
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (_u *PlatformUpdate) SetNillableSlug(v *string) *PlatformUpdate {
+	if v != nil {
+		_u.SetSlug(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *PlatformUpdate) SetName(v string) *PlatformUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *PlatformUpdate) SetNillableName(v *string) *PlatformUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// AddMovieIDs adds the "movies" edge to the Movie entity by IDs.
+func (_u *PlatformUpdate) AddMovieIDs(ids ...int) *PlatformUpdate {
+	_u.mutation.AddMovieIDs(ids...)
+	return _u
+}
+
+// AddMovies adds the "movies" edges to the Movie entity.
+func (_u *PlatformUpdate) AddMovies(v ...*Movie) *PlatformUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMovieIDs(ids...)
+}
+
+// Mutation returns the PlatformMutation object of the builder.
+func (_u *PlatformUpdate) Mutation() *PlatformMutation {
+	return _u.mutation
+}
+
+// ClearMovies clears all "movies" edges to the Movie entity.
+func (_u *PlatformUpdate) ClearMovies() *PlatformUpdate {
+	_u.mutation.ClearMovies()
+	return _u
+}
+
+// RemoveMovieIDs removes the "movies" edge to Movie entities by IDs.
+func (_u *PlatformUpdate) RemoveMovieIDs(ids ...int) *PlatformUpdate {
+	_u.mutation.RemoveMovieIDs(ids...)
+	return _u
+}
+
+// RemoveMovies removes "movies" edges to Movie entities.
+func (_u *PlatformUpdate) RemoveMovies(v ...*Movie) *PlatformUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMovieIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PlatformUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PlatformUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PlatformUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PlatformUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PlatformUpdate) check() error {
+	if v, ok := _u.mutation.Slug(); ok {
+		if err := platform.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Platform.slug": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := platform.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Platform.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PlatformUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(platform.Table, platform.Columns, sqlgraph.NewFieldSpec(platform.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Slug(); ok {
+		_spec.SetField(platform.FieldSlug, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(platform.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.MoviesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   platform.MoviesTable,
+			Columns: platform.MoviesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(movie.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMoviesIDs(); len(nodes) > 0 && !_u.mutation.MoviesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   platform.MoviesTable,
+			Columns: platform.MoviesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(movie.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MoviesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   platform.MoviesTable,
+			Columns: platform.MoviesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(movie.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{platform.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PlatformUpdateOne is the builder for updating a single Platform entity.
+type PlatformUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PlatformMutation
+}
+
+// SetSlug sets the "slug" field.
+func (_u *PlatformUpdateOne) SetSlug(v string) *PlatformUpdateOne {
+	_u.mutation.SetSlug(v)
+	return _u
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (_u *PlatformUpdateOne) SetNillableSlug(v *string) *PlatformUpdateOne {
+	if v != nil {
+		_u.SetSlug(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *PlatformUpdateOne) SetName(v string) *PlatformUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *PlatformUpdateOne) SetNillableName(v *string) *PlatformUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// AddMovieIDs adds the "movies" edge to the Movie entity by IDs.
+func (_u *PlatformUpdateOne) AddMovieIDs(ids ...int) *PlatformUpdateOne {
+	_u.mutation.AddMovieIDs(ids...)
+	return _u
+}
+
+// AddMovies adds the "movies" edges to the Movie entity.
+func (_u *PlatformUpdateOne) AddMovies(v ...*Movie) *PlatformUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMovieIDs(ids...)
+}
+
+// Mutation returns the PlatformMutation object of the builder.
+func (_u *PlatformUpdateOne) Mutation() *PlatformMutation {
+	return _u.mutation
+}
+
+// ClearMovies clears all "movies" edges to the Movie entity.
+func (_u *PlatformUpdateOne) ClearMovies() *PlatformUpdateOne {
+	_u.mutation.ClearMovies()
+	return _u
+}
+
+// RemoveMovieIDs removes the "movies" edge to Movie entities by IDs.
+func (_u *PlatformUpdateOne) RemoveMovieIDs(ids ...int) *PlatformUpdateOne {
+	_u.mutation.RemoveMovieIDs(ids...)
+	return _u
+}
+
+// RemoveMovies removes "movies" edges to Movie entities.
+func (_u *PlatformUpdateOne) RemoveMovies(v ...*Movie) *PlatformUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMovieIDs(ids...)
+}
+
+// Where appends a list predicates to the PlatformUpdate builder.
+func (_u *PlatformUpdateOne) Where(ps ...predicate.Platform) *PlatformUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PlatformUpdateOne) Select(field string, fields ...string) *PlatformUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Platform entity.
+func (_u *PlatformUpdateOne) Save(ctx context.Context) (*Platform, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PlatformUpdateOne) SaveX(ctx context.Context) *Platform {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PlatformUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PlatformUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PlatformUpdateOne) check() error {
+	if v, ok := _u.mutation.Slug(); ok {
+		if err := platform.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Platform.slug": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := platform.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Platform.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PlatformUpdateOne) sqlSave(ctx context.Context) (_node *Platform, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(platform.Table, platform.Columns, sqlgraph.NewFieldSpec(platform.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Platform.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, platform.FieldID)
+		for _, f := range fields {
+			if !platform.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != platform.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Slug(); ok {
+		_spec.SetField(platform.FieldSlug, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(platform.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.MoviesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   platform.MoviesTable,
+			Columns: platform.MoviesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(movie.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMoviesIDs(); len(nodes) > 0 && !_u.mutation.MoviesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   platform.MoviesTable,
+			Columns: platform.MoviesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(movie.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MoviesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   platform.MoviesTable,
+			Columns: platform.MoviesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(movie.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Platform{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{platform.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
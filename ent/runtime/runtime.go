@@ -2,7 +2,330 @@
 
 package runtime
 
-// The schema-stitching logic is generated in watchlist-app/ent/runtime.go
+import (
+	"time"
+	"watchlist-app/ent/auditentry"
+	"watchlist-app/ent/credit"
+	"watchlist-app/ent/episode"
+	"watchlist-app/ent/list"
+	"watchlist-app/ent/listentry"
+	"watchlist-app/ent/movie"
+	"watchlist-app/ent/person"
+	"watchlist-app/ent/platform"
+	"watchlist-app/ent/refreshtoken"
+	"watchlist-app/ent/schema"
+	"watchlist-app/ent/season"
+	"watchlist-app/ent/tag"
+	"watchlist-app/ent/user"
+	"watchlist-app/ent/watchevent"
+	"watchlist-app/ent/webhook"
+	"watchlist-app/ent/webhookdelivery"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	auditentryHooks := schema.AuditEntry{}.Hooks()
+	auditentry.Hooks[0] = auditentryHooks[0]
+	auditentryFields := schema.AuditEntry{}.Fields()
+	_ = auditentryFields
+	// auditentryDescCreatedAt is the schema descriptor for created_at field.
+	auditentryDescCreatedAt := auditentryFields[4].Descriptor()
+	// auditentry.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditentry.DefaultCreatedAt = auditentryDescCreatedAt.Default.(func() time.Time)
+	creditFields := schema.Credit{}.Fields()
+	_ = creditFields
+	// creditDescCharacter is the schema descriptor for character field.
+	creditDescCharacter := creditFields[3].Descriptor()
+	// credit.CharacterValidator is a validator for the "character" field. It is called by the builders before save.
+	credit.CharacterValidator = creditDescCharacter.Validators[0].(func(string) error)
+	episodeFields := schema.Episode{}.Fields()
+	_ = episodeFields
+	// episodeDescNumber is the schema descriptor for number field.
+	episodeDescNumber := episodeFields[1].Descriptor()
+	// episode.NumberValidator is a validator for the "number" field. It is called by the builders before save.
+	episode.NumberValidator = episodeDescNumber.Validators[0].(func(int) error)
+	// episodeDescCreatedAt is the schema descriptor for created_at field.
+	episodeDescCreatedAt := episodeFields[4].Descriptor()
+	// episode.DefaultCreatedAt holds the default value on creation for the created_at field.
+	episode.DefaultCreatedAt = episodeDescCreatedAt.Default.(func() time.Time)
+	listFields := schema.List{}.Fields()
+	_ = listFields
+	// listDescName is the schema descriptor for name field.
+	listDescName := listFields[0].Descriptor()
+	// list.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	list.NameValidator = func() func(string) error {
+		validators := listDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// listDescCreatedAt is the schema descriptor for created_at field.
+	listDescCreatedAt := listFields[3].Descriptor()
+	// list.DefaultCreatedAt holds the default value on creation for the created_at field.
+	list.DefaultCreatedAt = listDescCreatedAt.Default.(func() time.Time)
+	// listDescUpdatedAt is the schema descriptor for updated_at field.
+	listDescUpdatedAt := listFields[4].Descriptor()
+	// list.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	list.DefaultUpdatedAt = listDescUpdatedAt.Default.(func() time.Time)
+	// list.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	list.UpdateDefaultUpdatedAt = listDescUpdatedAt.UpdateDefault.(func() time.Time)
+	listentryFields := schema.ListEntry{}.Fields()
+	_ = listentryFields
+	// listentryDescPosition is the schema descriptor for position field.
+	listentryDescPosition := listentryFields[2].Descriptor()
+	// listentry.PositionValidator is a validator for the "position" field. It is called by the builders before save.
+	listentry.PositionValidator = listentryDescPosition.Validators[0].(func(int) error)
+	// listentryDescAddedAt is the schema descriptor for added_at field.
+	listentryDescAddedAt := listentryFields[3].Descriptor()
+	// listentry.DefaultAddedAt holds the default value on creation for the added_at field.
+	listentry.DefaultAddedAt = listentryDescAddedAt.Default.(func() time.Time)
+	movieMixin := schema.Movie{}.Mixin()
+	movieMixinHooks0 := movieMixin[0].Hooks()
+	movieHooks := schema.Movie{}.Hooks()
+	movie.Hooks[0] = movieMixinHooks0[0]
+	movie.Hooks[1] = movieMixinHooks0[1]
+	movie.Hooks[2] = movieHooks[0]
+	movie.Hooks[3] = movieHooks[1]
+	movieMixinInters0 := movieMixin[0].Interceptors()
+	movie.Interceptors[0] = movieMixinInters0[0]
+	movieFields := schema.Movie{}.Fields()
+	_ = movieFields
+	// movieDescTitle is the schema descriptor for title field.
+	movieDescTitle := movieFields[0].Descriptor()
+	// movie.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	movie.TitleValidator = movieDescTitle.Validators[0].(func(string) error)
+	// movieDescRating is the schema descriptor for rating field.
+	movieDescRating := movieFields[7].Descriptor()
+	// movie.RatingValidator is a validator for the "rating" field. It is called by the builders before save.
+	movie.RatingValidator = movieDescRating.Validators[0].(func(int) error)
+	// movieDescCreatedAt is the schema descriptor for created_at field.
+	movieDescCreatedAt := movieFields[10].Descriptor()
+	// movie.DefaultCreatedAt holds the default value on creation for the created_at field.
+	movie.DefaultCreatedAt = movieDescCreatedAt.Default.(func() time.Time)
+	// movieDescUpdatedAt is the schema descriptor for updated_at field.
+	movieDescUpdatedAt := movieFields[11].Descriptor()
+	// movie.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	movie.DefaultUpdatedAt = movieDescUpdatedAt.Default.(func() time.Time)
+	// movie.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	movie.UpdateDefaultUpdatedAt = movieDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// movieDescVersion is the schema descriptor for version field.
+	movieDescVersion := movieFields[12].Descriptor()
+	// movie.DefaultVersion holds the default value on creation for the version field.
+	movie.DefaultVersion = movieDescVersion.Default.(int)
+	personFields := schema.Person{}.Fields()
+	_ = personFields
+	// personDescName is the schema descriptor for name field.
+	personDescName := personFields[0].Descriptor()
+	// person.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	person.NameValidator = func() func(string) error {
+		validators := personDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// personDescCreatedAt is the schema descriptor for created_at field.
+	personDescCreatedAt := personFields[2].Descriptor()
+	// person.DefaultCreatedAt holds the default value on creation for the created_at field.
+	person.DefaultCreatedAt = personDescCreatedAt.Default.(func() time.Time)
+	platformFields := schema.Platform{}.Fields()
+	_ = platformFields
+	// platformDescSlug is the schema descriptor for slug field.
+	platformDescSlug := platformFields[0].Descriptor()
+	// platform.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	platform.SlugValidator = func() func(string) error {
+		validators := platformDescSlug.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+			validators[2].(func(string) error),
+		}
+		return func(slug string) error {
+			for _, fn := range fns {
+				if err := fn(slug); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// platformDescName is the schema descriptor for name field.
+	platformDescName := platformFields[1].Descriptor()
+	// platform.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	platform.NameValidator = func() func(string) error {
+		validators := platformDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// platformDescCreatedAt is the schema descriptor for created_at field.
+	platformDescCreatedAt := platformFields[2].Descriptor()
+	// platform.DefaultCreatedAt holds the default value on creation for the created_at field.
+	platform.DefaultCreatedAt = platformDescCreatedAt.Default.(func() time.Time)
+	refreshtokenFields := schema.RefreshToken{}.Fields()
+	_ = refreshtokenFields
+	// refreshtokenDescTokenHash is the schema descriptor for token_hash field.
+	refreshtokenDescTokenHash := refreshtokenFields[0].Descriptor()
+	// refreshtoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	refreshtoken.TokenHashValidator = refreshtokenDescTokenHash.Validators[0].(func(string) error)
+	// refreshtokenDescCreatedAt is the schema descriptor for created_at field.
+	refreshtokenDescCreatedAt := refreshtokenFields[4].Descriptor()
+	// refreshtoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	refreshtoken.DefaultCreatedAt = refreshtokenDescCreatedAt.Default.(func() time.Time)
+	seasonFields := schema.Season{}.Fields()
+	_ = seasonFields
+	// seasonDescNumber is the schema descriptor for number field.
+	seasonDescNumber := seasonFields[1].Descriptor()
+	// season.NumberValidator is a validator for the "number" field. It is called by the builders before save.
+	season.NumberValidator = seasonDescNumber.Validators[0].(func(int) error)
+	// seasonDescCreatedAt is the schema descriptor for created_at field.
+	seasonDescCreatedAt := seasonFields[3].Descriptor()
+	// season.DefaultCreatedAt holds the default value on creation for the created_at field.
+	season.DefaultCreatedAt = seasonDescCreatedAt.Default.(func() time.Time)
+	tagFields := schema.Tag{}.Fields()
+	_ = tagFields
+	// tagDescName is the schema descriptor for name field.
+	tagDescName := tagFields[0].Descriptor()
+	// tag.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tag.NameValidator = func() func(string) error {
+		validators := tagDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+			validators[2].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// tagDescCreatedAt is the schema descriptor for created_at field.
+	tagDescCreatedAt := tagFields[2].Descriptor()
+	// tag.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tag.DefaultCreatedAt = tagDescCreatedAt.Default.(func() time.Time)
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescEmail is the schema descriptor for email field.
+	userDescEmail := userFields[0].Descriptor()
+	// user.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	user.EmailValidator = userDescEmail.Validators[0].(func(string) error)
+	// userDescPasswordHash is the schema descriptor for password_hash field.
+	userDescPasswordHash := userFields[1].Descriptor()
+	// user.PasswordHashValidator is a validator for the "password_hash" field. It is called by the builders before save.
+	user.PasswordHashValidator = userDescPasswordHash.Validators[0].(func(string) error)
+	// userDescCreatedAt is the schema descriptor for created_at field.
+	userDescCreatedAt := userFields[3].Descriptor()
+	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
+	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
+	// userDescUpdatedAt is the schema descriptor for updated_at field.
+	userDescUpdatedAt := userFields[4].Descriptor()
+	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
+	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
+	watcheventFields := schema.WatchEvent{}.Fields()
+	_ = watcheventFields
+	// watcheventDescWatchedAt is the schema descriptor for watched_at field.
+	watcheventDescWatchedAt := watcheventFields[1].Descriptor()
+	// watchevent.DefaultWatchedAt holds the default value on creation for the watched_at field.
+	watchevent.DefaultWatchedAt = watcheventDescWatchedAt.Default.(func() time.Time)
+	// watcheventDescRating is the schema descriptor for rating field.
+	watcheventDescRating := watcheventFields[2].Descriptor()
+	// watchevent.RatingValidator is a validator for the "rating" field. It is called by the builders before save.
+	watchevent.RatingValidator = watcheventDescRating.Validators[0].(func(int) error)
+	// watcheventDescLocation is the schema descriptor for location field.
+	watcheventDescLocation := watcheventFields[4].Descriptor()
+	// watchevent.LocationValidator is a validator for the "location" field. It is called by the builders before save.
+	watchevent.LocationValidator = watcheventDescLocation.Validators[0].(func(string) error)
+	// watcheventDescCreatedAt is the schema descriptor for created_at field.
+	watcheventDescCreatedAt := watcheventFields[5].Descriptor()
+	// watchevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	watchevent.DefaultCreatedAt = watcheventDescCreatedAt.Default.(func() time.Time)
+	webhookFields := schema.Webhook{}.Fields()
+	_ = webhookFields
+	// webhookDescURL is the schema descriptor for url field.
+	webhookDescURL := webhookFields[1].Descriptor()
+	// webhook.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	webhook.URLValidator = func() func(string) error {
+		validators := webhookDescURL.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(url string) error {
+			for _, fn := range fns {
+				if err := fn(url); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// webhookDescSecret is the schema descriptor for secret field.
+	webhookDescSecret := webhookFields[2].Descriptor()
+	// webhook.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	webhook.SecretValidator = webhookDescSecret.Validators[0].(func(string) error)
+	// webhookDescActive is the schema descriptor for active field.
+	webhookDescActive := webhookFields[4].Descriptor()
+	// webhook.DefaultActive holds the default value on creation for the active field.
+	webhook.DefaultActive = webhookDescActive.Default.(bool)
+	// webhookDescCreatedAt is the schema descriptor for created_at field.
+	webhookDescCreatedAt := webhookFields[5].Descriptor()
+	// webhook.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhook.DefaultCreatedAt = webhookDescCreatedAt.Default.(func() time.Time)
+	// webhookDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookDescUpdatedAt := webhookFields[6].Descriptor()
+	// webhook.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhook.DefaultUpdatedAt = webhookDescUpdatedAt.Default.(func() time.Time)
+	// webhook.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhook.UpdateDefaultUpdatedAt = webhookDescUpdatedAt.UpdateDefault.(func() time.Time)
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescEvent is the schema descriptor for event field.
+	webhookdeliveryDescEvent := webhookdeliveryFields[1].Descriptor()
+	// webhookdelivery.EventValidator is a validator for the "event" field. It is called by the builders before save.
+	webhookdelivery.EventValidator = webhookdeliveryDescEvent.Validators[0].(func(string) error)
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[5].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int)
+	// webhookdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryFields[11].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() time.Time)
+}
 
 const (
 	Version = "v0.14.5"                                         // Version of ent codegen.
@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"watchlist-app/pkg/audit"
+)
+
+// AuditEntry holds the schema definition for the AuditEntry entity.
+// 作品が完全削除された後も履歴を残すため、movies への外部キーは張らない。
+type AuditEntry struct {
+	ent.Schema
+}
+
+// Fields of the AuditEntry.
+func (AuditEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("movie_id").
+			Immutable().
+			Comment("対象の作品ID"),
+		field.Int("actor_id").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("操作したユーザーID（定期処理などシステムによる操作は NULL）"),
+		field.Enum("action").
+			Values("create", "update", "delete", "restore", "purge", "revert").
+			Immutable().
+			Comment("操作種別"),
+		field.JSON("changes", audit.Changes{}).
+			Optional().
+			Immutable().
+			Comment("変更されたフィールドごとの変更前・変更後の値"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("操作日時"),
+	}
+}
+
+// Hooks of the AuditEntry.
+func (AuditEntry) Hooks() []ent.Hook {
+	return []ent.Hook{
+		WebhookHook(),
+	}
+}
+
+// Indexes of the AuditEntry.
+func (AuditEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("movie_id", "created_at"),
+	}
+}
@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Availability holds the schema definition for the Availability entity.
+// Movie と Platform の多対多を表し、配信期間を保持する。
+type Availability struct {
+	ent.Schema
+}
+
+// Annotations of the Availability.
+func (Availability) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		field.ID("movie_id", "platform_id"),
+	}
+}
+
+// Fields of the Availability.
+func (Availability) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("movie_id").
+			Comment("作品ID"),
+		field.Int("platform_id").
+			Comment("配信サービスID"),
+		field.Time("available_from").
+			Optional().
+			Nillable().
+			Comment("配信開始日（未設定は開始日不明・配信中として扱う）"),
+		field.Time("available_until").
+			Optional().
+			Nillable().
+			Comment("配信終了日（未設定は終了予定なし）"),
+	}
+}
+
+// Edges of the Availability.
+func (Availability) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("movie", Movie.Type).
+			Field("movie_id").
+			Unique().
+			Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("platform", Platform.Type).
+			Field("platform_id").
+			Unique().
+			Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the Availability.
+func (Availability) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("platform_id", "available_until"),
+	}
+}
@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Credit holds the schema definition for the Credit entity.
+// Person と Movie を結び、その作品での役割を保持する。
+// 同じ人物が1つの作品で複数の役割（監督と出演など）を持てるよう、Through エッジではなく独立したエンティティとする。
+type Credit struct {
+	ent.Schema
+}
+
+// Fields of the Credit.
+func (Credit) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("person_id").
+			Comment("人物ID"),
+		field.Int("movie_id").
+			Comment("作品ID"),
+		field.Enum("role").
+			Values("director", "actor", "studio").
+			Comment("役割"),
+		field.String("character").
+			Optional().
+			MaxLen(200).
+			Comment("役名（出演時のみ）"),
+	}
+}
+
+// Edges of the Credit.
+func (Credit) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("person", Person.Type).
+			Ref("credits").
+			Field("person_id").
+			Unique().
+			Required(),
+		edge.From("movie", Movie.Type).
+			Ref("credits").
+			Field("movie_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the Credit.
+func (Credit) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("movie_id", "person_id", "role").Unique(),
+		index.Fields("person_id"),
+	}
+}
@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"watchlist-app/pkg/entgraphql"
+)
+
+// Episode holds the schema definition for the Episode entity.
+type Episode struct {
+	ent.Schema
+}
+
+// Annotations of the Episode.
+func (Episode) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgraphql.Type("Episode"),
+	}
+}
+
+// Fields of the Episode.
+func (Episode) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("season_id").
+			Annotations(entgraphql.Skip()).
+			Comment("シーズンID"),
+		field.Int("number").
+			Positive().
+			Comment("話数"),
+		field.String("title").
+			Optional().
+			Comment("エピソードタイトル"),
+		field.Time("watched_at").
+			Optional().
+			Nillable().
+			Comment("視聴日時（未視聴の場合は NULL）"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Annotations(entgraphql.Skip()).
+			Comment("作成日時"),
+	}
+}
+
+// Edges of the Episode.
+func (Episode) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("season", Season.Type).
+			Ref("episodes").
+			Field("season_id").
+			Unique().
+			Required().
+			Annotations(entgraphql.Skip()),
+	}
+}
+
+// Indexes of the Episode.
+func (Episode) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("season_id", "number").Unique(),
+	}
+}
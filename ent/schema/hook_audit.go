@@ -0,0 +1,253 @@
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"entgo.io/ent"
+
+	gen "watchlist-app/ent"
+	"watchlist-app/ent/auditentry"
+	"watchlist-app/ent/hook"
+	"watchlist-app/ent/movie"
+	"watchlist-app/pkg/audit"
+	"watchlist-app/pkg/auth"
+)
+
+// 履歴に残さないフィールド（全ての更新で変わるため）
+var auditIgnoredFields = map[string]bool{
+	movie.FieldCreatedAt: true,
+	movie.FieldUpdatedAt: true,
+	movie.FieldVersion:   true,
+}
+
+type auditActionKey struct{}
+
+// WithAuditAction は ctx で行う更新を指定した操作種別（リビジョンへの巻き戻しなど）として記録させる。
+func WithAuditAction(ctx context.Context, action auditentry.Action) context.Context {
+	return context.WithValue(ctx, auditActionKey{}, action)
+}
+
+// AuditHook は Movie の作成・更新・削除を AuditEntry に記録する。
+// 記録は同じトランザクション内で行われるため、変更がロールバックされた場合は履歴も残らない。
+func AuditHook() ent.Hook {
+	return hook.On(
+		func(next ent.Mutator) ent.Mutator {
+			return hook.MovieFunc(func(ctx context.Context, m *gen.MovieMutation) (ent.Value, error) {
+				switch {
+				case m.Op().Is(ent.OpCreate):
+					return auditCreate(ctx, next, m)
+				case m.Op().Is(ent.OpUpdateOne):
+					return auditUpdateOne(ctx, next, m)
+				case m.Op().Is(ent.OpUpdate):
+					return auditUpdate(ctx, next, m)
+				default:
+					return auditDelete(ctx, next, m)
+				}
+			})
+		},
+		ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne|ent.OpDelete|ent.OpDeleteOne,
+	)
+}
+
+func auditCreate(ctx context.Context, next ent.Mutator, m *gen.MovieMutation) (ent.Value, error) {
+	v, err := next.Mutate(ctx, m)
+	if err != nil {
+		return nil, err
+	}
+	created, ok := v.(*gen.Movie)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation result %T", v)
+	}
+
+	changes := audit.Changes{}
+	for _, name := range m.Fields() {
+		if auditIgnoredFields[name] {
+			continue
+		}
+		value, _ := m.Field(name)
+		changes[name] = audit.FieldChange{New: value}
+	}
+
+	if err := saveAuditEntry(ctx, m, created.ID, auditentry.ActionCreate, changes); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func auditUpdateOne(ctx context.Context, next ent.Mutator, m *gen.MovieMutation) (ent.Value, error) {
+	id, ok := m.ID()
+	if !ok {
+		return next.Mutate(ctx, m)
+	}
+
+	// 変更前の値はミューテーション実行前に取得する
+	changes := audit.Changes{}
+	for _, name := range m.Fields() {
+		if auditIgnoredFields[name] {
+			continue
+		}
+		old, err := m.OldField(ctx, name)
+		if err != nil {
+			// 対象が存在しない場合は更新自体が NotFound になる
+			return next.Mutate(ctx, m)
+		}
+		value, _ := m.Field(name)
+		changes[name] = audit.FieldChange{Old: old, New: value}
+	}
+	for _, name := range m.ClearedFields() {
+		if auditIgnoredFields[name] {
+			continue
+		}
+		old, err := m.OldField(ctx, name)
+		if err != nil {
+			return next.Mutate(ctx, m)
+		}
+		changes[name] = audit.FieldChange{Old: old}
+	}
+
+	v, err := next.Mutate(ctx, m)
+	if err != nil {
+		return nil, err
+	}
+
+	changes = withoutUnchanged(changes)
+	if len(changes) == 0 {
+		return v, nil
+	}
+	if err := saveAuditEntry(ctx, m, id, auditAction(ctx, m), changes); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func auditUpdate(ctx context.Context, next ent.Mutator, m *gen.MovieMutation) (ent.Value, error) {
+	ids, err := m.IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	snapshots, err := movieSnapshots(ctx, m, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := next.Mutate(ctx, m)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range ids {
+		before := snapshots[id]
+		changes := audit.Changes{}
+		for _, name := range m.Fields() {
+			if auditIgnoredFields[name] {
+				continue
+			}
+			value, _ := m.Field(name)
+			changes[name] = audit.FieldChange{Old: before[name], New: value}
+		}
+		for _, name := range m.ClearedFields() {
+			if auditIgnoredFields[name] {
+				continue
+			}
+			changes[name] = audit.FieldChange{Old: before[name]}
+		}
+
+		changes = withoutUnchanged(changes)
+		if len(changes) == 0 {
+			continue
+		}
+		if err := saveAuditEntry(ctx, m, id, auditAction(ctx, m), changes); err != nil {
+			return nil, err
+		}
+	}
+	return v, nil
+}
+
+// 物理削除（ゴミ箱からの完全削除）。論理削除は SoftDeleteMixin により更新として記録される
+func auditDelete(ctx context.Context, next ent.Mutator, m *gen.MovieMutation) (ent.Value, error) {
+	ids, err := m.IDs(SkipSoftDelete(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := next.Mutate(ctx, m)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range ids {
+		if err := saveAuditEntry(ctx, m, id, auditentry.ActionPurge, nil); err != nil {
+			return nil, err
+		}
+	}
+	return v, nil
+}
+
+// deleted_at の設定・解除は削除・復元として記録する
+func auditAction(ctx context.Context, m *gen.MovieMutation) auditentry.Action {
+	if _, ok := m.DeletedAt(); ok {
+		return auditentry.ActionDelete
+	}
+	if m.DeletedAtCleared() {
+		return auditentry.ActionRestore
+	}
+	if action, ok := ctx.Value(auditActionKey{}).(auditentry.Action); ok {
+		return action
+	}
+	return auditentry.ActionUpdate
+}
+
+func saveAuditEntry(ctx context.Context, m *gen.MovieMutation, movieID int, action auditentry.Action, changes audit.Changes) error {
+	builder := m.Client().AuditEntry.Create().
+		SetMovieID(movieID).
+		SetAction(action)
+	if changes != nil {
+		builder = builder.SetChanges(changes)
+	}
+	if actorID, ok := auth.UserIDFromContext(ctx); ok {
+		builder = builder.SetActorID(actorID)
+	}
+
+	if err := builder.Exec(ctx); err != nil {
+		return fmt.Errorf("saving audit entry: %w", err)
+	}
+	return nil
+}
+
+// 変更対象の作品をフィールド名 → 値のマップとして取得（一括更新時の変更前の値）
+func movieSnapshots(ctx context.Context, m *gen.MovieMutation, ids []int) (map[int]map[string]any, error) {
+	movies, err := m.Client().Movie.Query().
+		Where(movie.IDIn(ids...)).
+		All(SkipSoftDelete(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make(map[int]map[string]any, len(movies))
+	for _, mv := range movies {
+		b, err := json.Marshal(mv)
+		if err != nil {
+			return nil, err
+		}
+		var snapshot map[string]any
+		if err := json.Unmarshal(b, &snapshot); err != nil {
+			return nil, err
+		}
+		snapshots[mv.ID] = snapshot
+	}
+	return snapshots, nil
+}
+
+// 変更前後で値が同じフィールドを除く
+func withoutUnchanged(changes audit.Changes) audit.Changes {
+	for name, c := range changes {
+		oldJSON, _ := json.Marshal(c.Old)
+		newJSON, _ := json.Marshal(c.New)
+		if string(oldJSON) == string(newJSON) {
+			delete(changes, name)
+		}
+	}
+	return changes
+}
@@ -0,0 +1,26 @@
+package schema
+
+import (
+	"context"
+
+	"entgo.io/ent"
+
+	gen "watchlist-app/ent"
+	"watchlist-app/ent/hook"
+)
+
+// VersionHook は Movie の更新（論理削除・復元を含む）のたびに version を1つ加算する。
+// 更新条件に version を含めることで、他の更新と競合した場合は対象の行が見つからなくなる。
+func VersionHook() ent.Hook {
+	return hook.On(
+		func(next ent.Mutator) ent.Mutator {
+			return hook.MovieFunc(func(ctx context.Context, m *gen.MovieMutation) (ent.Value, error) {
+				if _, ok := m.Version(); !ok {
+					m.AddVersion(1)
+				}
+				return next.Mutate(ctx, m)
+			})
+		},
+		ent.OpUpdate|ent.OpUpdateOne,
+	)
+}
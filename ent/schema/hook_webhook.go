@@ -0,0 +1,150 @@
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"entgo.io/ent"
+
+	gen "watchlist-app/ent"
+	"watchlist-app/ent/auditentry"
+	"watchlist-app/ent/hook"
+	"watchlist-app/ent/movie"
+	entwebhook "watchlist-app/ent/webhook"
+	"watchlist-app/pkg/audit"
+	"watchlist-app/pkg/webhook"
+)
+
+// WebhookHook は作品の変更履歴の記録に合わせて、所有者の Webhook への配信を登録する。
+// 配信の登録は変更と同じトランザクション内で行われるため、ロールバックされた変更は通知されない。
+// 実際の送信は WebhookService が非同期に行う。
+func WebhookHook() ent.Hook {
+	return hook.On(
+		func(next ent.Mutator) ent.Mutator {
+			return hook.AuditEntryFunc(func(ctx context.Context, m *gen.AuditEntryMutation) (ent.Value, error) {
+				v, err := next.Mutate(ctx, m)
+				if err != nil {
+					return nil, err
+				}
+				if err := enqueueWebhookDeliveries(ctx, m); err != nil {
+					return nil, err
+				}
+				return v, nil
+			})
+		},
+		ent.OpCreate,
+	)
+}
+
+func enqueueWebhookDeliveries(ctx context.Context, m *gen.AuditEntryMutation) error {
+	action, _ := m.Action()
+	changes, _ := m.Changes()
+	events := webhookEvents(action, changes)
+	if len(events) == 0 {
+		return nil
+	}
+
+	movieID, _ := m.MovieID()
+	mv, err := m.Client().Movie.Query().
+		Where(movie.IDEQ(movieID)).
+		Only(SkipSoftDelete(ctx))
+	if err != nil {
+		return fmt.Errorf("querying movie for webhook: %w", err)
+	}
+	// 所有者のいない作品は通知先がない
+	if mv.UserID == 0 {
+		return nil
+	}
+
+	hooks, err := m.Client().Webhook.Query().
+		Where(entwebhook.UserIDEQ(mv.UserID), entwebhook.Active(true)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("querying webhooks: %w", err)
+	}
+	if len(hooks) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	var builders []*gen.WebhookDeliveryCreate
+	for _, event := range events {
+		payload := webhook.Payload{
+			Event:     event,
+			CreatedAt: now,
+			Movie:     webhookMovie(mv),
+		}
+		if actorID, ok := m.ActorID(); ok {
+			payload.ActorID = &actorID
+		}
+		if event == webhook.EventMovieStatusChanged || event == webhook.EventMovieRated {
+			payload.Changes = changes
+		}
+		body, err := json.Marshal(payload)
+		if err != nil {
+			return fmt.Errorf("encoding webhook payload: %w", err)
+		}
+
+		for _, h := range hooks {
+			if !slices.Contains(h.Events, event) {
+				continue
+			}
+			builders = append(builders, m.Client().WebhookDelivery.Create().
+				SetWebhookID(h.ID).
+				SetEvent(event).
+				SetMovieID(mv.ID).
+				SetPayload(string(body)).
+				SetNextAttemptAt(now))
+		}
+	}
+	if len(builders) == 0 {
+		return nil
+	}
+
+	if err := m.Client().WebhookDelivery.CreateBulk(builders...).Exec(ctx); err != nil {
+		return fmt.Errorf("saving webhook deliveries: %w", err)
+	}
+	return nil
+}
+
+// 変更履歴の操作種別と変更内容から、配信するイベントを決める
+// 評価は設定された場合のみ、ゴミ箱からの復元・完全削除は通知しない
+func webhookEvents(action auditentry.Action, changes audit.Changes) []string {
+	switch action {
+	case auditentry.ActionCreate:
+		return []string{webhook.EventMovieCreated}
+	case auditentry.ActionDelete:
+		return []string{webhook.EventMovieDeleted}
+	case auditentry.ActionUpdate, auditentry.ActionRevert:
+		var events []string
+		if _, ok := changes[movie.FieldWatchStatus]; ok {
+			events = append(events, webhook.EventMovieStatusChanged)
+		}
+		if c, ok := changes[movie.FieldRating]; ok && c.New != nil {
+			events = append(events, webhook.EventMovieRated)
+		}
+		return events
+	}
+	return nil
+}
+
+func webhookMovie(mv *gen.Movie) webhook.Movie {
+	m := webhook.Movie{
+		ID:          mv.ID,
+		Title:       mv.Title,
+		Genre:       mv.Genre,
+		ReleaseYear: mv.ReleaseYear,
+		MediaType:   string(mv.MediaType),
+		WatchStatus: string(mv.WatchStatus),
+		Rating:      mv.Rating,
+		Version:     mv.Version,
+	}
+	if !mv.WatchedAt.IsZero() {
+		watchedAt := mv.WatchedAt
+		m.WatchedAt = &watchedAt
+	}
+	return m
+}
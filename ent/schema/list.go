@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// List holds the schema definition for the List entity.
+type List struct {
+	ent.Schema
+}
+
+// Fields of the List.
+func (List) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			MaxLen(100).
+			Comment("リスト名"),
+		field.Text("description").
+			Optional().
+			Comment("リストの説明"),
+		field.Int("user_id").
+			Comment("所有ユーザーID"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("作成日時"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("更新日時"),
+	}
+}
+
+// Edges of the List.
+func (List) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).
+			Ref("lists").
+			Field("user_id").
+			Unique().
+			Required(),
+		edge.To("movies", Movie.Type).
+			Through("entries", ListEntry.Type),
+	}
+}
+
+// Indexes of the List.
+func (List) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ListEntry holds the schema definition for the ListEntry entity.
+// List と Movie の多対多を表し、リスト内の並び順を保持する。
+type ListEntry struct {
+	ent.Schema
+}
+
+// Annotations of the ListEntry.
+func (ListEntry) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		field.ID("list_id", "movie_id"),
+	}
+}
+
+// Fields of the ListEntry.
+func (ListEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("list_id").
+			Comment("リストID"),
+		field.Int("movie_id").
+			Comment("作品ID"),
+		field.Int("position").
+			NonNegative().
+			Comment("リスト内の並び順（0始まり）"),
+		field.Time("added_at").
+			Default(time.Now).
+			Immutable().
+			Comment("追加日時"),
+	}
+}
+
+// Edges of the ListEntry.
+func (ListEntry) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("list", List.Type).
+			Field("list_id").
+			Unique().
+			Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("movie", Movie.Type).
+			Field("movie_id").
+			Unique().
+			Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the ListEntry.
+func (ListEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("list_id", "position"),
+	}
+}
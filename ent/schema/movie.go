@@ -4,8 +4,13 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
+
+	"watchlist-app/pkg/entgraphql"
 )
 
 // Movie holds the schema definition for the Movie entity.
@@ -13,11 +18,35 @@ type Movie struct {
 	ent.Schema
 }
 
+// Annotations of the Movie.
+// GraphQL の Movie 型は internal/graph/ent.graphqls に生成する（タグ・視聴履歴などは schema.graphqls で追加する）
+func (Movie) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgraphql.Type("Movie"),
+	}
+}
+
+// Mixin of the Movie.
+func (Movie) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SoftDeleteMixin{},
+	}
+}
+
+// Hooks of the Movie.
+func (Movie) Hooks() []ent.Hook {
+	return []ent.Hook{
+		VersionHook(),
+		AuditHook(),
+	}
+}
+
 // Fields of the Movie.
 func (Movie) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("title").NotEmpty().Comment("映画・ドラマのタイトル"),
 		field.Text("description").Optional().Comment("概要・あらすじ"),
+		// 複数ジャンルはタグ（tags エッジ）で管理する。互換性のため単一ジャンルも残す
 		field.String("genre").
 			Optional().
 			Comment("ジャンル"),
@@ -30,10 +59,12 @@ func (Movie) Fields() []ent.Field {
 		field.Enum("media_type").
 			Values("movie", "tv_series", "documentary", "anime").
 			Default("movie").
+			Annotations(entgraphql.Type("MediaType")).
 			Comment("メディアタイプ"),
 		field.Enum("watch_status").
 			Values("want_to_watch", "watching", "completed", "dropped").
 			Default("want_to_watch").
+			Annotations(entgraphql.Type("WatchStatus")).
 			Comment("視聴ステータス"),
 		field.Int("rating").
 			Optional().
@@ -42,6 +73,7 @@ func (Movie) Fields() []ent.Field {
 		field.Text("review").
 			Optional().
 			Comment("レビュー・感想"),
+		// 視聴履歴（watch_events）の最新の視聴日。履歴の追加・削除時に同期する
 		field.Time("watched_at").
 			Optional().
 			Comment("視聴完了日"),
@@ -53,12 +85,39 @@ func (Movie) Fields() []ent.Field {
 			Default(time.Now).
 			UpdateDefault(time.Now).
 			Comment("更新日時"),
+		// 楽観的排他制御（ETag・If-Match）に使う。更新のたびに VersionHook が加算する
+		field.Int("version").
+			Default(1).
+			Comment("バージョン"),
+		// 既存データを移行できるよう NULL を許容する（所有者のいない行はどのユーザーにも表示されない）
+		field.Int("user_id").
+			Optional().
+			Annotations(entgraphql.Skip()).
+			Comment("所有ユーザーID"),
 	}
 }
 
 // Edges of the Movie.
 func (Movie) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		edge.From("owner", User.Type).
+			Ref("movies").
+			Field("user_id").
+			Unique(),
+		edge.To("seasons", Season.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("tags", Tag.Type),
+		// GraphQL では件数を指定できるよう schema.graphqls で定義する
+		edge.To("watch_events", WatchEvent.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade), entgraphql.Skip()),
+		edge.From("lists", List.Type).
+			Ref("movies").
+			Through("list_entries", ListEntry.Type),
+		edge.To("credits", Credit.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("platforms", Platform.Type).
+			Through("availabilities", Availability.Type),
+	}
 }
 
 // Indexes of the Movie.
@@ -69,5 +128,7 @@ func (Movie) Indexes() []ent.Index {
 		index.Fields("watch_status"),
 		index.Fields("media_type"),
 		index.Fields("created_at"),
+		index.Fields("user_id", "created_at"),
+		index.Fields("deleted_at"),
 	}
 }
@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"regexp"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// 配信サービスの識別子（英小文字・数字・アンダースコア）
+var platformSlugPattern = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+// Platform holds the schema definition for the Platform entity.
+// 配信サービス（Netflix、Prime Video など）。全ユーザーで共通のマスタとする。
+type Platform struct {
+	ent.Schema
+}
+
+// Fields of the Platform.
+func (Platform) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("slug").
+			Unique().
+			NotEmpty().
+			MaxLen(50).
+			Match(platformSlugPattern).
+			Comment("識別子（netflix, prime_video など。絞り込みに使用）"),
+		field.String("name").
+			NotEmpty().
+			MaxLen(100).
+			Comment("表示名"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("作成日時"),
+	}
+}
+
+// Edges of the Platform.
+func (Platform) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("movies", Movie.Type).
+			Ref("platforms").
+			Through("availabilities", Availability.Type),
+	}
+}
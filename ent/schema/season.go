@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"watchlist-app/pkg/entgraphql"
+)
+
+// Season holds the schema definition for the Season entity.
+type Season struct {
+	ent.Schema
+}
+
+// Annotations of the Season.
+func (Season) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgraphql.Type("Season"),
+	}
+}
+
+// Fields of the Season.
+func (Season) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("movie_id").
+			Annotations(entgraphql.Skip()).
+			Comment("作品ID"),
+		field.Int("number").
+			Positive().
+			Comment("シーズン番号"),
+		field.String("title").
+			Optional().
+			Comment("シーズンタイトル"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Annotations(entgraphql.Skip()).
+			Comment("作成日時"),
+	}
+}
+
+// Edges of the Season.
+func (Season) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("movie", Movie.Type).
+			Ref("seasons").
+			Field("movie_id").
+			Unique().
+			Required().
+			Annotations(entgraphql.Skip()),
+		edge.To("episodes", Episode.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the Season.
+func (Season) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("movie_id", "number").Unique(),
+	}
+}
@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"regexp"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// タグ名（CSV のエクスポート・取り込みでカンマ区切りにするため、カンマを含まない）
+var tagNamePattern = regexp.MustCompile(`^[^,]*$`)
+
+// Tag holds the schema definition for the Tag entity.
+type Tag struct {
+	ent.Schema
+}
+
+// Fields of the Tag.
+func (Tag) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			MaxRuneLen(50).
+			Match(tagNamePattern).
+			Comment("タグ名"),
+		field.Int("user_id").
+			Comment("所有ユーザーID"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("作成日時"),
+	}
+}
+
+// Edges of the Tag.
+func (Tag) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).
+			Ref("tags").
+			Field("user_id").
+			Unique().
+			Required(),
+		edge.From("movies", Movie.Type).
+			Ref("tags"),
+	}
+}
+
+// Indexes of the Tag.
+func (Tag) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "name").Unique(),
+	}
+}
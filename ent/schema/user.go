@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// User holds the schema definition for the User entity.
+type User struct {
+	ent.Schema
+}
+
+// Fields of the User.
+func (User) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("email").
+			NotEmpty().
+			Unique().
+			Comment("メールアドレス（ログインID）"),
+		field.String("password_hash").
+			NotEmpty().
+			Sensitive().
+			Comment("パスワードハッシュ（bcrypt）"),
+		field.String("name").
+			Optional().
+			Comment("表示名"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("作成日時"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("更新日時"),
+	}
+}
+
+// Edges of the User.
+func (User) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("movies", Movie.Type),
+		edge.To("refresh_tokens", RefreshToken.Type),
+		edge.To("tags", Tag.Type),
+		edge.To("lists", List.Type),
+		edge.To("people", Person.Type),
+		edge.To("webhooks", Webhook.Type),
+	}
+}
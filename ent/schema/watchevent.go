@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"watchlist-app/pkg/entgraphql"
+)
+
+// WatchEvent holds the schema definition for the WatchEvent entity.
+type WatchEvent struct {
+	ent.Schema
+}
+
+// Annotations of the WatchEvent.
+func (WatchEvent) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgraphql.Type("WatchEvent"),
+	}
+}
+
+// Fields of the WatchEvent.
+func (WatchEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("movie_id").
+			Annotations(entgraphql.Skip()).
+			Comment("作品ID"),
+		field.Time("watched_at").
+			Default(time.Now).
+			Comment("視聴日"),
+		field.Int("rating").
+			Optional().
+			Range(1, 5).
+			Comment("この視聴時の評価（1-5）"),
+		field.Text("note").
+			Optional().
+			Comment("この視聴時のメモ"),
+		field.String("location").
+			Optional().
+			MaxLen(100).
+			Comment("視聴場所・手段（映画館、配信サービスなど）"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Annotations(entgraphql.Skip()).
+			Comment("作成日時"),
+	}
+}
+
+// Edges of the WatchEvent.
+func (WatchEvent) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("movie", Movie.Type).
+			Ref("watch_events").
+			Field("movie_id").
+			Unique().
+			Required().
+			Annotations(entgraphql.Skip()),
+	}
+}
+
+// Indexes of the WatchEvent.
+func (WatchEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("movie_id", "watched_at"),
+	}
+}
@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Webhook holds the schema definition for the Webhook entity.
+// 作品の作成・視聴ステータスの変更などを登録した URL に通知する購読設定。
+type Webhook struct {
+	ent.Schema
+}
+
+// Fields of the Webhook.
+func (Webhook) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id").
+			Comment("所有ユーザーID"),
+		field.String("url").
+			NotEmpty().
+			MaxLen(2000).
+			Comment("配信先URL"),
+		field.String("secret").
+			NotEmpty().
+			Sensitive().
+			Comment("署名に使う共有シークレット"),
+		field.Strings("events").
+			Comment("購読するイベントの種類"),
+		field.Bool("active").
+			Default(true).
+			Comment("有効かどうか（無効の間はイベントを配信しない）"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("作成日時"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("更新日時"),
+	}
+}
+
+// Edges of the Webhook.
+func (Webhook) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("webhooks").
+			Field("user_id").
+			Unique().
+			Required(),
+		edge.To("deliveries", WebhookDelivery.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the Webhook.
+func (Webhook) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
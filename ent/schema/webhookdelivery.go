@@ -0,0 +1,86 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WebhookDelivery holds the schema definition for the WebhookDelivery entity.
+// 1件のイベントの配信と、その試行結果の記録。再送は別の配信として記録する。
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+// Fields of the WebhookDelivery.
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("webhook_id").
+			Immutable().
+			Comment("配信先のWebhookID"),
+		field.String("event").
+			NotEmpty().
+			Immutable().
+			Comment("イベントの種類"),
+		field.Int("movie_id").
+			Immutable().
+			Comment("対象の作品ID（完全削除後も記録を残すため外部キーは張らない）"),
+		field.Text("payload").
+			Immutable().
+			Comment("送信する本文（再送時も同じ内容を送る）"),
+		field.Enum("status").
+			Values("pending", "succeeded", "failed").
+			Default("pending").
+			Comment("配信状況"),
+		field.Int("attempts").
+			Default(0).
+			Comment("試行回数"),
+		field.Time("next_attempt_at").
+			Optional().
+			Nillable().
+			Comment("次に送信する日時（配信が完了・失敗した場合は NULL）"),
+		field.Int("response_status").
+			Optional().
+			Nillable().
+			Comment("最後の試行のHTTPステータス"),
+		field.Text("last_error").
+			Optional().
+			Comment("最後の試行のエラー内容"),
+		field.Time("delivered_at").
+			Optional().
+			Nillable().
+			Comment("配信に成功した日時"),
+		field.Int("redelivery_of").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("手動で再送した場合の元の配信ID"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("作成日時"),
+	}
+}
+
+// Edges of the WebhookDelivery.
+func (WebhookDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("webhook", Webhook.Type).
+			Ref("deliveries").
+			Field("webhook_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the WebhookDelivery.
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("webhook_id", "created_at"),
+		index.Fields("status", "next_attempt_at"),
+	}
+}
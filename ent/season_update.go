@@ -0,0 +1,585 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"watchlist-app/ent/episode"
+	"watchlist-app/ent/movie"
+	"watchlist-app/ent/predicate"
+	"watchlist-app/ent/season"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SeasonUpdate is the builder for updating Season entities.
+type SeasonUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SeasonMutation
+}
+
+// Where appends a list predicates to the SeasonUpdate builder.
+func (_u *SeasonUpdate) Where(ps ...predicate.Season) *SeasonUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetMovieID sets the "movie_id" field.
+func (_u *SeasonUpdate) SetMovieID(v int) *SeasonUpdate {
+	_u.mutation.SetMovieID(v)
+	return _u
+}
+
+// SetNillableMovieID sets the "movie_id" field if the given value is not nil.
+func (_u *SeasonUpdate) SetNillableMovieID(v *int) *SeasonUpdate {
+	if v != nil {
+		_u.SetMovieID(*v)
+	}
+	return _u
+}
+
+// SetNumber sets the "number" field.
+func (_u *SeasonUpdate) SetNumber(v int) *SeasonUpdate {
+	_u.mutation.ResetNumber()
+	_u.mutation.SetNumber(v)
+	return _u
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (_u *SeasonUpdate) SetNillableNumber(v *int) *SeasonUpdate {
+	if v != nil {
+		_u.SetNumber(*v)
+	}
+	return _u
+}
+
+// AddNumber adds value to the "number" field.
+func (_u *SeasonUpdate) AddNumber(v int) *SeasonUpdate {
+	_u.mutation.AddNumber(v)
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *SeasonUpdate) SetTitle(v string) *SeasonUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *SeasonUpdate) SetNillableTitle(v *string) *SeasonUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// ClearTitle clears the value of the "title" field.
+func (_u *SeasonUpdate) ClearTitle() *SeasonUpdate {
+	_u.mutation.ClearTitle()
+	return _u
+}
+
+// SetMovie sets the "movie" edge to the Movie entity.
+func (_u *SeasonUpdate) SetMovie(v *Movie) *SeasonUpdate {
+	return _u.SetMovieID(v.ID)
+}
+
+// AddEpisodeIDs adds the "episodes" edge to the Episode entity by IDs.
+func (_u *SeasonUpdate) AddEpisodeIDs(ids ...int) *SeasonUpdate {
+	_u.mutation.AddEpisodeIDs(ids...)
+	return _u
+}
+
+// AddEpisodes adds the "episodes" edges to the Episode entity.
+func (_u *SeasonUpdate) AddEpisodes(v ...*Episode) *SeasonUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEpisodeIDs(ids...)
+}
+
+// Mutation returns the SeasonMutation object of the builder.
+func (_u *SeasonUpdate) Mutation() *SeasonMutation {
+	return _u.mutation
+}
+
+// ClearMovie clears the "movie" edge to the Movie entity.
+func (_u *SeasonUpdate) ClearMovie() *SeasonUpdate {
+	_u.mutation.ClearMovie()
+	return _u
+}
+
+// ClearEpisodes clears all "episodes" edges to the Episode entity.
+func (_u *SeasonUpdate) ClearEpisodes() *SeasonUpdate {
+	_u.mutation.ClearEpisodes()
+	return _u
+}
+
+// RemoveEpisodeIDs removes the "episodes" edge to Episode entities by IDs.
+func (_u *SeasonUpdate) RemoveEpisodeIDs(ids ...int) *SeasonUpdate {
+	_u.mutation.RemoveEpisodeIDs(ids...)
+	return _u
+}
+
+// RemoveEpisodes removes "episodes" edges to Episode entities.
+func (_u *SeasonUpdate) RemoveEpisodes(v ...*Episode) *SeasonUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEpisodeIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SeasonUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SeasonUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SeasonUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SeasonUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SeasonUpdate) check() error {
+	if v, ok := _u.mutation.Number(); ok {
+		if err := season.NumberValidator(v); err != nil {
+			return &ValidationError{Name: "number", err: fmt.Errorf(`ent: validator failed for field "Season.number": %w`, err)}
+		}
+	}
+	if _u.mutation.MovieCleared() && len(_u.mutation.MovieIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Season.movie"`)
+	}
+	return nil
+}
+
+func (_u *SeasonUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(season.Table, season.Columns, sqlgraph.NewFieldSpec(season.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Number(); ok {
+		_spec.SetField(season.FieldNumber, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedNumber(); ok {
+		_spec.AddField(season.FieldNumber, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(season.FieldTitle, field.TypeString, value)
+	}
+	if _u.mutation.TitleCleared() {
+		_spec.ClearField(season.FieldTitle, field.TypeString)
+	}
+	if _u.mutation.MovieCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   season.MovieTable,
+			Columns: []string{season.MovieColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(movie.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MovieIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   season.MovieTable,
+			Columns: []string{season.MovieColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(movie.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EpisodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.EpisodesTable,
+			Columns: []string{season.EpisodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEpisodesIDs(); len(nodes) > 0 && !_u.mutation.EpisodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.EpisodesTable,
+			Columns: []string{season.EpisodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EpisodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.EpisodesTable,
+			Columns: []string{season.EpisodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{season.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SeasonUpdateOne is the builder for updating a single Season entity.
+type SeasonUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SeasonMutation
+}
+
+// SetMovieID sets the "movie_id" field.
+func (_u *SeasonUpdateOne) SetMovieID(v int) *SeasonUpdateOne {
+	_u.mutation.SetMovieID(v)
+	return _u
+}
+
+// SetNillableMovieID sets the "movie_id" field if the given value is not nil.
+func (_u *SeasonUpdateOne) SetNillableMovieID(v *int) *SeasonUpdateOne {
+	if v != nil {
+		_u.SetMovieID(*v)
+	}
+	return _u
+}
+
+// SetNumber sets the "number" field.
+func (_u *SeasonUpdateOne) SetNumber(v int) *SeasonUpdateOne {
+	_u.mutation.ResetNumber()
+	_u.mutation.SetNumber(v)
+	return _u
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (_u *SeasonUpdateOne) SetNillableNumber(v *int) *SeasonUpdateOne {
+	if v != nil {
+		_u.SetNumber(*v)
+	}
+	return _u
+}
+
+// AddNumber adds value to the "number" field.
+func (_u *SeasonUpdateOne) AddNumber(v int) *SeasonUpdateOne {
+	_u.mutation.AddNumber(v)
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *SeasonUpdateOne) SetTitle(v string) *SeasonUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *SeasonUpdateOne) SetNillableTitle(v *string) *SeasonUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// ClearTitle clears the value of the "title" field.
+func (_u *SeasonUpdateOne) ClearTitle() *SeasonUpdateOne {
+	_u.mutation.ClearTitle()
+	return _u
+}
+
+// SetMovie sets the "movie" edge to the Movie entity.
+func (_u *SeasonUpdateOne) SetMovie(v *Movie) *SeasonUpdateOne {
+	return _u.SetMovieID(v.ID)
+}
+
+// AddEpisodeIDs adds the "episodes" edge to the Episode entity by IDs.
+func (_u *SeasonUpdateOne) AddEpisodeIDs(ids ...int) *SeasonUpdateOne {
+	_u.mutation.AddEpisodeIDs(ids...)
+	return _u
+}
+
+// AddEpisodes adds the "episodes" edges to the Episode entity.
+func (_u *SeasonUpdateOne) AddEpisodes(v ...*Episode) *SeasonUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEpisodeIDs(ids...)
+}
+
+// Mutation returns the SeasonMutation object of the builder.
+func (_u *SeasonUpdateOne) Mutation() *SeasonMutation {
+	return _u.mutation
+}
+
+// ClearMovie clears the "movie" edge to the Movie entity.
+func (_u *SeasonUpdateOne) ClearMovie() *SeasonUpdateOne {
+	_u.mutation.ClearMovie()
+	return _u
+}
+
+// ClearEpisodes clears all "episodes" edges to the Episode entity.
+func (_u *SeasonUpdateOne) ClearEpisodes() *SeasonUpdateOne {
+	_u.mutation.ClearEpisodes()
+	return _u
+}
+
+// RemoveEpisodeIDs removes the "episodes" edge to Episode entities by IDs.
+func (_u *SeasonUpdateOne) RemoveEpisodeIDs(ids ...int) *SeasonUpdateOne {
+	_u.mutation.RemoveEpisodeIDs(ids...)
+	return _u
+}
+
+// RemoveEpisodes removes "episodes" edges to Episode entities.
+func (_u *SeasonUpdateOne) RemoveEpisodes(v ...*Episode) *SeasonUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEpisodeIDs(ids...)
+}
+
+// Where appends a list predicates to the SeasonUpdate builder.
+func (_u *SeasonUpdateOne) Where(ps ...predicate.Season) *SeasonUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SeasonUpdateOne) Select(field string, fields ...string) *SeasonUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Season entity.
+func (_u *SeasonUpdateOne) Save(ctx context.Context) (*Season, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SeasonUpdateOne) SaveX(ctx context.Context) *Season {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SeasonUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SeasonUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SeasonUpdateOne) check() error {
+	if v, ok := _u.mutation.Number(); ok {
+		if err := season.NumberValidator(v); err != nil {
+			return &ValidationError{Name: "number", err: fmt.Errorf(`ent: validator failed for field "Season.number": %w`, err)}
+		}
+	}
+	if _u.mutation.MovieCleared() && len(_u.mutation.MovieIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Season.movie"`)
+	}
+	return nil
+}
+
+func (_u *SeasonUpdateOne) sqlSave(ctx context.Context) (_node *Season, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(season.Table, season.Columns, sqlgraph.NewFieldSpec(season.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Season.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, season.FieldID)
+		for _, f := range fields {
+			if !season.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != season.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Number(); ok {
+		_spec.SetField(season.FieldNumber, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedNumber(); ok {
+		_spec.AddField(season.FieldNumber, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(season.FieldTitle, field.TypeString, value)
+	}
+	if _u.mutation.TitleCleared() {
+		_spec.ClearField(season.FieldTitle, field.TypeString)
+	}
+	if _u.mutation.MovieCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   season.MovieTable,
+			Columns: []string{season.MovieColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(movie.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MovieIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   season.MovieTable,
+			Columns: []string{season.MovieColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(movie.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EpisodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.EpisodesTable,
+			Columns: []string{season.EpisodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEpisodesIDs(); len(nodes) > 0 && !_u.mutation.EpisodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.EpisodesTable,
+			Columns: []string{season.EpisodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EpisodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.EpisodesTable,
+			Columns: []string{season.EpisodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Season{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{season.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,190 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"watchlist-app/ent/movie"
+	"watchlist-app/ent/watchevent"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// WatchEvent is the model entity for the WatchEvent schema.
+type WatchEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 作品ID
+	MovieID int `json:"movie_id,omitempty"`
+	// 視聴日
+	WatchedAt time.Time `json:"watched_at,omitempty"`
+	// この視聴時の評価（1-5）
+	Rating int `json:"rating,omitempty"`
+	// この視聴時のメモ
+	Note string `json:"note,omitempty"`
+	// 視聴場所・手段（映画館、配信サービスなど）
+	Location string `json:"location,omitempty"`
+	// 作成日時
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WatchEventQuery when eager-loading is set.
+	Edges        WatchEventEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// WatchEventEdges holds the relations/edges for other nodes in the graph.
+type WatchEventEdges struct {
+	// Movie holds the value of the movie edge.
+	Movie *Movie `json:"movie,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// MovieOrErr returns the Movie value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WatchEventEdges) MovieOrErr() (*Movie, error) {
+	if e.Movie != nil {
+		return e.Movie, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: movie.Label}
+	}
+	return nil, &NotLoadedError{edge: "movie"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WatchEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case watchevent.FieldID, watchevent.FieldMovieID, watchevent.FieldRating:
+			values[i] = new(sql.NullInt64)
+		case watchevent.FieldNote, watchevent.FieldLocation:
+			values[i] = new(sql.NullString)
+		case watchevent.FieldWatchedAt, watchevent.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WatchEvent fields.
+func (_m *WatchEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case watchevent.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case watchevent.FieldMovieID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field movie_id", values[i])
+			} else if value.Valid {
+				_m.MovieID = int(value.Int64)
+			}
+		case watchevent.FieldWatchedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field watched_at", values[i])
+			} else if value.Valid {
+				_m.WatchedAt = value.Time
+			}
+		case watchevent.FieldRating:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rating", values[i])
+			} else if value.Valid {
+				_m.Rating = int(value.Int64)
+			}
+		case watchevent.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				_m.Note = value.String
+			}
+		case watchevent.FieldLocation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field location", values[i])
+			} else if value.Valid {
+				_m.Location = value.String
+			}
+		case watchevent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WatchEvent.
+// This includes values selected through modifiers, order, etc.
+func (_m *WatchEvent) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryMovie queries the "movie" edge of the WatchEvent entity.
+func (_m *WatchEvent) QueryMovie() *MovieQuery {
+	return NewWatchEventClient(_m.config).QueryMovie(_m)
+}
+
+// Update returns a builder for updating this WatchEvent.
+// Note that you need to call WatchEvent.Unwrap() before calling this method if this WatchEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *WatchEvent) Update() *WatchEventUpdateOne {
+	return NewWatchEventClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the WatchEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *WatchEvent) Unwrap() *WatchEvent {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WatchEvent is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *WatchEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("WatchEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("movie_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MovieID))
+	builder.WriteString(", ")
+	builder.WriteString("watched_at=")
+	builder.WriteString(_m.WatchedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("rating=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Rating))
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(_m.Note)
+	builder.WriteString(", ")
+	builder.WriteString("location=")
+	builder.WriteString(_m.Location)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WatchEvents is a parsable slice of WatchEvent.
+type WatchEvents []*WatchEvent
@@ -0,0 +1,424 @@
+// Code generated by ent, DO NOT EDIT.
+
+package watchevent
+
+import (
+	"time"
+	"watchlist-app/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldLTE(FieldID, id))
+}
+
+// MovieID applies equality check predicate on the "movie_id" field. It's identical to MovieIDEQ.
+func MovieID(v int) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldEQ(FieldMovieID, v))
+}
+
+// WatchedAt applies equality check predicate on the "watched_at" field. It's identical to WatchedAtEQ.
+func WatchedAt(v time.Time) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldEQ(FieldWatchedAt, v))
+}
+
+// Rating applies equality check predicate on the "rating" field. It's identical to RatingEQ.
+func Rating(v int) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldEQ(FieldRating, v))
+}
+
+// Note applies equality check predicate on the "note" field. It's identical to NoteEQ.
+func Note(v string) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldEQ(FieldNote, v))
+}
+
+// Location applies equality check predicate on the "location" field. It's identical to LocationEQ.
+func Location(v string) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldEQ(FieldLocation, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// MovieIDEQ applies the EQ predicate on the "movie_id" field.
+func MovieIDEQ(v int) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldEQ(FieldMovieID, v))
+}
+
+// MovieIDNEQ applies the NEQ predicate on the "movie_id" field.
+func MovieIDNEQ(v int) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldNEQ(FieldMovieID, v))
+}
+
+// MovieIDIn applies the In predicate on the "movie_id" field.
+func MovieIDIn(vs ...int) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldIn(FieldMovieID, vs...))
+}
+
+// MovieIDNotIn applies the NotIn predicate on the "movie_id" field.
+func MovieIDNotIn(vs ...int) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldNotIn(FieldMovieID, vs...))
+}
+
+// WatchedAtEQ applies the EQ predicate on the "watched_at" field.
+func WatchedAtEQ(v time.Time) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldEQ(FieldWatchedAt, v))
+}
+
+// WatchedAtNEQ applies the NEQ predicate on the "watched_at" field.
+func WatchedAtNEQ(v time.Time) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldNEQ(FieldWatchedAt, v))
+}
+
+// WatchedAtIn applies the In predicate on the "watched_at" field.
+func WatchedAtIn(vs ...time.Time) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldIn(FieldWatchedAt, vs...))
+}
+
+// WatchedAtNotIn applies the NotIn predicate on the "watched_at" field.
+func WatchedAtNotIn(vs ...time.Time) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldNotIn(FieldWatchedAt, vs...))
+}
+
+// WatchedAtGT applies the GT predicate on the "watched_at" field.
+func WatchedAtGT(v time.Time) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldGT(FieldWatchedAt, v))
+}
+
+// WatchedAtGTE applies the GTE predicate on the "watched_at" field.
+func WatchedAtGTE(v time.Time) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldGTE(FieldWatchedAt, v))
+}
+
+// WatchedAtLT applies the LT predicate on the "watched_at" field.
+func WatchedAtLT(v time.Time) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldLT(FieldWatchedAt, v))
+}
+
+// WatchedAtLTE applies the LTE predicate on the "watched_at" field.
+func WatchedAtLTE(v time.Time) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldLTE(FieldWatchedAt, v))
+}
+
+// RatingEQ applies the EQ predicate on the "rating" field.
+func RatingEQ(v int) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldEQ(FieldRating, v))
+}
+
+// RatingNEQ applies the NEQ predicate on the "rating" field.
+func RatingNEQ(v int) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldNEQ(FieldRating, v))
+}
+
+// RatingIn applies the In predicate on the "rating" field.
+func RatingIn(vs ...int) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldIn(FieldRating, vs...))
+}
+
+// RatingNotIn applies the NotIn predicate on the "rating" field.
+func RatingNotIn(vs ...int) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldNotIn(FieldRating, vs...))
+}
+
+// RatingGT applies the GT predicate on the "rating" field.
+func RatingGT(v int) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldGT(FieldRating, v))
+}
+
+// RatingGTE applies the GTE predicate on the "rating" field.
+func RatingGTE(v int) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldGTE(FieldRating, v))
+}
+
+// RatingLT applies the LT predicate on the "rating" field.
+func RatingLT(v int) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldLT(FieldRating, v))
+}
+
+// RatingLTE applies the LTE predicate on the "rating" field.
+func RatingLTE(v int) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldLTE(FieldRating, v))
+}
+
+// RatingIsNil applies the IsNil predicate on the "rating" field.
+func RatingIsNil() predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldIsNull(FieldRating))
+}
+
+// RatingNotNil applies the NotNil predicate on the "rating" field.
+func RatingNotNil() predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldNotNull(FieldRating))
+}
+
+// NoteEQ applies the EQ predicate on the "note" field.
+func NoteEQ(v string) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldEQ(FieldNote, v))
+}
+
+// NoteNEQ applies the NEQ predicate on the "note" field.
+func NoteNEQ(v string) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldNEQ(FieldNote, v))
+}
+
+// NoteIn applies the In predicate on the "note" field.
+func NoteIn(vs ...string) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldIn(FieldNote, vs...))
+}
+
+// NoteNotIn applies the NotIn predicate on the "note" field.
+func NoteNotIn(vs ...string) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldNotIn(FieldNote, vs...))
+}
+
+// NoteGT applies the GT predicate on the "note" field.
+func NoteGT(v string) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldGT(FieldNote, v))
+}
+
+// NoteGTE applies the GTE predicate on the "note" field.
+func NoteGTE(v string) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldGTE(FieldNote, v))
+}
+
+// NoteLT applies the LT predicate on the "note" field.
+func NoteLT(v string) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldLT(FieldNote, v))
+}
+
+// NoteLTE applies the LTE predicate on the "note" field.
+func NoteLTE(v string) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldLTE(FieldNote, v))
+}
+
+// NoteContains applies the Contains predicate on the "note" field.
+func NoteContains(v string) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldContains(FieldNote, v))
+}
+
+// NoteHasPrefix applies the HasPrefix predicate on the "note" field.
+func NoteHasPrefix(v string) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldHasPrefix(FieldNote, v))
+}
+
+// NoteHasSuffix applies the HasSuffix predicate on the "note" field.
+func NoteHasSuffix(v string) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldHasSuffix(FieldNote, v))
+}
+
+// NoteIsNil applies the IsNil predicate on the "note" field.
+func NoteIsNil() predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldIsNull(FieldNote))
+}
+
+// NoteNotNil applies the NotNil predicate on the "note" field.
+func NoteNotNil() predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldNotNull(FieldNote))
+}
+
+// NoteEqualFold applies the EqualFold predicate on the "note" field.
+func NoteEqualFold(v string) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldEqualFold(FieldNote, v))
+}
+
+// NoteContainsFold applies the ContainsFold predicate on the "note" field.
+func NoteContainsFold(v string) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldContainsFold(FieldNote, v))
+}
+
+// LocationEQ applies the EQ predicate on the "location" field.
+func LocationEQ(v string) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldEQ(FieldLocation, v))
+}
+
+// LocationNEQ applies the NEQ predicate on the "location" field.
+func LocationNEQ(v string) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldNEQ(FieldLocation, v))
+}
+
+// LocationIn applies the In predicate on the "location" field.
+func LocationIn(vs ...string) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldIn(FieldLocation, vs...))
+}
+
+// LocationNotIn applies the NotIn predicate on the "location" field.
+func LocationNotIn(vs ...string) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldNotIn(FieldLocation, vs...))
+}
+
+// LocationGT applies the GT predicate on the "location" field.
+func LocationGT(v string) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldGT(FieldLocation, v))
+}
+
+// LocationGTE applies the GTE predicate on the "location" field.
+func LocationGTE(v string) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldGTE(FieldLocation, v))
+}
+
+// LocationLT applies the LT predicate on the "location" field.
+func LocationLT(v string) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldLT(FieldLocation, v))
+}
+
+// LocationLTE applies the LTE predicate on the "location" field.
+func LocationLTE(v string) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldLTE(FieldLocation, v))
+}
+
+// LocationContains applies the Contains predicate on the "location" field.
+func LocationContains(v string) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldContains(FieldLocation, v))
+}
+
+// LocationHasPrefix applies the HasPrefix predicate on the "location" field.
+func LocationHasPrefix(v string) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldHasPrefix(FieldLocation, v))
+}
+
+// LocationHasSuffix applies the HasSuffix predicate on the "location" field.
+func LocationHasSuffix(v string) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldHasSuffix(FieldLocation, v))
+}
+
+// LocationIsNil applies the IsNil predicate on the "location" field.
+func LocationIsNil() predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldIsNull(FieldLocation))
+}
+
+// LocationNotNil applies the NotNil predicate on the "location" field.
+func LocationNotNil() predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldNotNull(FieldLocation))
+}
+
+// LocationEqualFold applies the EqualFold predicate on the "location" field.
+func LocationEqualFold(v string) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldEqualFold(FieldLocation, v))
+}
+
+// LocationContainsFold applies the ContainsFold predicate on the "location" field.
+func LocationContainsFold(v string) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldContainsFold(FieldLocation, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasMovie applies the HasEdge predicate on the "movie" edge.
+func HasMovie() predicate.WatchEvent {
+	return predicate.WatchEvent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, MovieTable, MovieColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMovieWith applies the HasEdge predicate on the "movie" edge with a given conditions (other predicates).
+func HasMovieWith(preds ...predicate.Movie) predicate.WatchEvent {
+	return predicate.WatchEvent(func(s *sql.Selector) {
+		step := newMovieStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WatchEvent) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WatchEvent) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WatchEvent) predicate.WatchEvent {
+	return predicate.WatchEvent(sql.NotPredicates(p))
+}
@@ -0,0 +1,326 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"watchlist-app/ent/movie"
+	"watchlist-app/ent/watchevent"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WatchEventCreate is the builder for creating a WatchEvent entity.
+type WatchEventCreate struct {
+	config
+	mutation *WatchEventMutation
+	hooks    []Hook
+}
+
+// SetMovieID sets the "movie_id" field.
+func (_c *WatchEventCreate) SetMovieID(v int) *WatchEventCreate {
+	_c.mutation.SetMovieID(v)
+	return _c
+}
+
+// SetWatchedAt sets the "watched_at" field.
+func (_c *WatchEventCreate) SetWatchedAt(v time.Time) *WatchEventCreate {
+	_c.mutation.SetWatchedAt(v)
+	return _c
+}
+
+// SetNillableWatchedAt sets the "watched_at" field if the given value is not nil.
+func (_c *WatchEventCreate) SetNillableWatchedAt(v *time.Time) *WatchEventCreate {
+	if v != nil {
+		_c.SetWatchedAt(*v)
+	}
+	return _c
+}
+
+// SetRating sets the "rating" field.
+func (_c *WatchEventCreate) SetRating(v int) *WatchEventCreate {
+	_c.mutation.SetRating(v)
+	return _c
+}
+
+// SetNillableRating sets the "rating" field if the given value is not nil.
+func (_c *WatchEventCreate) SetNillableRating(v *int) *WatchEventCreate {
+	if v != nil {
+		_c.SetRating(*v)
+	}
+	return _c
+}
+
+// SetNote sets the "note" field.
+func (_c *WatchEventCreate) SetNote(v string) *WatchEventCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_c *WatchEventCreate) SetNillableNote(v *string) *WatchEventCreate {
+	if v != nil {
+		_c.SetNote(*v)
+	}
+	return _c
+}
+
+// SetLocation sets the "location" field.
+func (_c *WatchEventCreate) SetLocation(v string) *WatchEventCreate {
+	_c.mutation.SetLocation(v)
+	return _c
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (_c *WatchEventCreate) SetNillableLocation(v *string) *WatchEventCreate {
+	if v != nil {
+		_c.SetLocation(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *WatchEventCreate) SetCreatedAt(v time.Time) *WatchEventCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *WatchEventCreate) SetNillableCreatedAt(v *time.Time) *WatchEventCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetMovie sets the "movie" edge to the Movie entity.
+func (_c *WatchEventCreate) SetMovie(v *Movie) *WatchEventCreate {
+	return _c.SetMovieID(v.ID)
+}
+
+// Mutation returns the WatchEventMutation object of the builder.
+func (_c *WatchEventCreate) Mutation() *WatchEventMutation {
+	return _c.mutation
+}
+
+// Save creates the WatchEvent in the database.
+func (_c *WatchEventCreate) Save(ctx context.Context) (*WatchEvent, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *WatchEventCreate) SaveX(ctx context.Context) *WatchEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WatchEventCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WatchEventCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *WatchEventCreate) defaults() {
+	if _, ok := _c.mutation.WatchedAt(); !ok {
+		v := watchevent.DefaultWatchedAt()
+		_c.mutation.SetWatchedAt(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := watchevent.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *WatchEventCreate) check() error {
+	if _, ok := _c.mutation.MovieID(); !ok {
+		return &ValidationError{Name: "movie_id", err: errors.New(`ent: missing required field "WatchEvent.movie_id"`)}
+	}
+	if _, ok := _c.mutation.WatchedAt(); !ok {
+		return &ValidationError{Name: "watched_at", err: errors.New(`ent: missing required field "WatchEvent.watched_at"`)}
+	}
+	if v, ok := _c.mutation.Rating(); ok {
+		if err := watchevent.RatingValidator(v); err != nil {
+			return &ValidationError{Name: "rating", err: fmt.Errorf(`ent: validator failed for field "WatchEvent.rating": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Location(); ok {
+		if err := watchevent.LocationValidator(v); err != nil {
+			return &ValidationError{Name: "location", err: fmt.Errorf(`ent: validator failed for field "WatchEvent.location": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WatchEvent.created_at"`)}
+	}
+	if len(_c.mutation.MovieIDs()) == 0 {
+		return &ValidationError{Name: "movie", err: errors.New(`ent: missing required edge "WatchEvent.movie"`)}
+	}
+	return nil
+}
+
+func (_c *WatchEventCreate) sqlSave(ctx context.Context) (*WatchEvent, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *WatchEventCreate) createSpec() (*WatchEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WatchEvent{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(watchevent.Table, sqlgraph.NewFieldSpec(watchevent.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.WatchedAt(); ok {
+		_spec.SetField(watchevent.FieldWatchedAt, field.TypeTime, value)
+		_node.WatchedAt = value
+	}
+	if value, ok := _c.mutation.Rating(); ok {
+		_spec.SetField(watchevent.FieldRating, field.TypeInt, value)
+		_node.Rating = value
+	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(watchevent.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if value, ok := _c.mutation.Location(); ok {
+		_spec.SetField(watchevent.FieldLocation, field.TypeString, value)
+		_node.Location = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(watchevent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.MovieIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   watchevent.MovieTable,
+			Columns: []string{watchevent.MovieColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(movie.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MovieID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// WatchEventCreateBulk is the builder for creating many WatchEvent entities in bulk.
+type WatchEventCreateBulk struct {
+	config
+	err      error
+	builders []*WatchEventCreate
+}
+
+// Save creates the WatchEvent entities in the database.
+func (_c *WatchEventCreateBulk) Save(ctx context.Context) ([]*WatchEvent, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*WatchEvent, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WatchEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *WatchEventCreateBulk) SaveX(ctx context.Context) []*WatchEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WatchEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WatchEventCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
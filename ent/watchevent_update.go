@@ -0,0 +1,537 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"watchlist-app/ent/movie"
+	"watchlist-app/ent/predicate"
+	"watchlist-app/ent/watchevent"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WatchEventUpdate is the builder for updating WatchEvent entities.
+type WatchEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WatchEventMutation
+}
+
+// Where appends a list predicates to the WatchEventUpdate builder.
+func (_u *WatchEventUpdate) Where(ps ...predicate.WatchEvent) *WatchEventUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetMovieID sets the "movie_id" field.
+func (_u *WatchEventUpdate) SetMovieID(v int) *WatchEventUpdate {
+	_u.mutation.SetMovieID(v)
+	return _u
+}
+
+// SetNillableMovieID sets the "movie_id" field if the given value is not nil.
+func (_u *WatchEventUpdate) SetNillableMovieID(v *int) *WatchEventUpdate {
+	if v != nil {
+		_u.SetMovieID(*v)
+	}
+	return _u
+}
+
+// SetWatchedAt sets the "watched_at" field.
+func (_u *WatchEventUpdate) SetWatchedAt(v time.Time) *WatchEventUpdate {
+	_u.mutation.SetWatchedAt(v)
+	return _u
+}
+
+// SetNillableWatchedAt sets the "watched_at" field if the given value is not nil.
+func (_u *WatchEventUpdate) SetNillableWatchedAt(v *time.Time) *WatchEventUpdate {
+	if v != nil {
+		_u.SetWatchedAt(*v)
+	}
+	return _u
+}
+
+// SetRating sets the "rating" field.
+func (_u *WatchEventUpdate) SetRating(v int) *WatchEventUpdate {
+	_u.mutation.ResetRating()
+	_u.mutation.SetRating(v)
+	return _u
+}
+
+// SetNillableRating sets the "rating" field if the given value is not nil.
+func (_u *WatchEventUpdate) SetNillableRating(v *int) *WatchEventUpdate {
+	if v != nil {
+		_u.SetRating(*v)
+	}
+	return _u
+}
+
+// AddRating adds value to the "rating" field.
+func (_u *WatchEventUpdate) AddRating(v int) *WatchEventUpdate {
+	_u.mutation.AddRating(v)
+	return _u
+}
+
+// ClearRating clears the value of the "rating" field.
+func (_u *WatchEventUpdate) ClearRating() *WatchEventUpdate {
+	_u.mutation.ClearRating()
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *WatchEventUpdate) SetNote(v string) *WatchEventUpdate {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *WatchEventUpdate) SetNillableNote(v *string) *WatchEventUpdate {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *WatchEventUpdate) ClearNote() *WatchEventUpdate {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetLocation sets the "location" field.
+func (_u *WatchEventUpdate) SetLocation(v string) *WatchEventUpdate {
+	_u.mutation.SetLocation(v)
+	return _u
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (_u *WatchEventUpdate) SetNillableLocation(v *string) *WatchEventUpdate {
+	if v != nil {
+		_u.SetLocation(*v)
+	}
+	return _u
+}
+
+// ClearLocation clears the value of the "location" field.
+func (_u *WatchEventUpdate) ClearLocation() *WatchEventUpdate {
+	_u.mutation.ClearLocation()
+	return _u
+}
+
+// SetMovie sets the "movie" edge to the Movie entity.
+func (_u *WatchEventUpdate) SetMovie(v *Movie) *WatchEventUpdate {
+	return _u.SetMovieID(v.ID)
+}
+
+// Mutation returns the WatchEventMutation object of the builder.
+func (_u *WatchEventUpdate) Mutation() *WatchEventMutation {
+	return _u.mutation
+}
+
+// ClearMovie clears the "movie" edge to the Movie entity.
+func (_u *WatchEventUpdate) ClearMovie() *WatchEventUpdate {
+	_u.mutation.ClearMovie()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *WatchEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WatchEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *WatchEventUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WatchEventUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WatchEventUpdate) check() error {
+	if v, ok := _u.mutation.Rating(); ok {
+		if err := watchevent.RatingValidator(v); err != nil {
+			return &ValidationError{Name: "rating", err: fmt.Errorf(`ent: validator failed for field "WatchEvent.rating": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Location(); ok {
+		if err := watchevent.LocationValidator(v); err != nil {
+			return &ValidationError{Name: "location", err: fmt.Errorf(`ent: validator failed for field "WatchEvent.location": %w`, err)}
+		}
+	}
+	if _u.mutation.MovieCleared() && len(_u.mutation.MovieIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WatchEvent.movie"`)
+	}
+	return nil
+}
+
+func (_u *WatchEventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(watchevent.Table, watchevent.Columns, sqlgraph.NewFieldSpec(watchevent.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.WatchedAt(); ok {
+		_spec.SetField(watchevent.FieldWatchedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Rating(); ok {
+		_spec.SetField(watchevent.FieldRating, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRating(); ok {
+		_spec.AddField(watchevent.FieldRating, field.TypeInt, value)
+	}
+	if _u.mutation.RatingCleared() {
+		_spec.ClearField(watchevent.FieldRating, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(watchevent.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(watchevent.FieldNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.Location(); ok {
+		_spec.SetField(watchevent.FieldLocation, field.TypeString, value)
+	}
+	if _u.mutation.LocationCleared() {
+		_spec.ClearField(watchevent.FieldLocation, field.TypeString)
+	}
+	if _u.mutation.MovieCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   watchevent.MovieTable,
+			Columns: []string{watchevent.MovieColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(movie.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MovieIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   watchevent.MovieTable,
+			Columns: []string{watchevent.MovieColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(movie.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{watchevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// WatchEventUpdateOne is the builder for updating a single WatchEvent entity.
+type WatchEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WatchEventMutation
+}
+
+// SetMovieID sets the "movie_id" field.
+func (_u *WatchEventUpdateOne) SetMovieID(v int) *WatchEventUpdateOne {
+	_u.mutation.SetMovieID(v)
+	return _u
+}
+
+// SetNillableMovieID sets the "movie_id" field if the given value is not nil.
+func (_u *WatchEventUpdateOne) SetNillableMovieID(v *int) *WatchEventUpdateOne {
+	if v != nil {
+		_u.SetMovieID(*v)
+	}
+	return _u
+}
+
+// SetWatchedAt sets the "watched_at" field.
+func (_u *WatchEventUpdateOne) SetWatchedAt(v time.Time) *WatchEventUpdateOne {
+	_u.mutation.SetWatchedAt(v)
+	return _u
+}
+
+// SetNillableWatchedAt sets the "watched_at" field if the given value is not nil.
+func (_u *WatchEventUpdateOne) SetNillableWatchedAt(v *time.Time) *WatchEventUpdateOne {
+	if v != nil {
+		_u.SetWatchedAt(*v)
+	}
+	return _u
+}
+
+// SetRating sets the "rating" field.
+func (_u *WatchEventUpdateOne) SetRating(v int) *WatchEventUpdateOne {
+	_u.mutation.ResetRating()
+	_u.mutation.SetRating(v)
+	return _u
+}
+
+// SetNillableRating sets the "rating" field if the given value is not nil.
+func (_u *WatchEventUpdateOne) SetNillableRating(v *int) *WatchEventUpdateOne {
+	if v != nil {
+		_u.SetRating(*v)
+	}
+	return _u
+}
+
+// AddRating adds value to the "rating" field.
+func (_u *WatchEventUpdateOne) AddRating(v int) *WatchEventUpdateOne {
+	_u.mutation.AddRating(v)
+	return _u
+}
+
+// ClearRating clears the value of the "rating" field.
+func (_u *WatchEventUpdateOne) ClearRating() *WatchEventUpdateOne {
+	_u.mutation.ClearRating()
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *WatchEventUpdateOne) SetNote(v string) *WatchEventUpdateOne {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *WatchEventUpdateOne) SetNillableNote(v *string) *WatchEventUpdateOne {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *WatchEventUpdateOne) ClearNote() *WatchEventUpdateOne {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetLocation sets the "location" field.
+func (_u *WatchEventUpdateOne) SetLocation(v string) *WatchEventUpdateOne {
+	_u.mutation.SetLocation(v)
+	return _u
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (_u *WatchEventUpdateOne) SetNillableLocation(v *string) *WatchEventUpdateOne {
+	if v != nil {
+		_u.SetLocation(*v)
+	}
+	return _u
+}
+
+// ClearLocation clears the value of the "location" field.
+func (_u *WatchEventUpdateOne) ClearLocation() *WatchEventUpdateOne {
+	_u.mutation.ClearLocation()
+	return _u
+}
+
+// SetMovie sets the "movie" edge to the Movie entity.
+func (_u *WatchEventUpdateOne) SetMovie(v *Movie) *WatchEventUpdateOne {
+	return _u.SetMovieID(v.ID)
+}
+
+// Mutation returns the WatchEventMutation object of the builder.
+func (_u *WatchEventUpdateOne) Mutation() *WatchEventMutation {
+	return _u.mutation
+}
+
+// ClearMovie clears the "movie" edge to the Movie entity.
+func (_u *WatchEventUpdateOne) ClearMovie() *WatchEventUpdateOne {
+	_u.mutation.ClearMovie()
+	return _u
+}
+
+// Where appends a list predicates to the WatchEventUpdate builder.
+func (_u *WatchEventUpdateOne) Where(ps ...predicate.WatchEvent) *WatchEventUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *WatchEventUpdateOne) Select(field string, fields ...string) *WatchEventUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated WatchEvent entity.
+func (_u *WatchEventUpdateOne) Save(ctx context.Context) (*WatchEvent, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WatchEventUpdateOne) SaveX(ctx context.Context) *WatchEvent {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *WatchEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WatchEventUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WatchEventUpdateOne) check() error {
+	if v, ok := _u.mutation.Rating(); ok {
+		if err := watchevent.RatingValidator(v); err != nil {
+			return &ValidationError{Name: "rating", err: fmt.Errorf(`ent: validator failed for field "WatchEvent.rating": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Location(); ok {
+		if err := watchevent.LocationValidator(v); err != nil {
+			return &ValidationError{Name: "location", err: fmt.Errorf(`ent: validator failed for field "WatchEvent.location": %w`, err)}
+		}
+	}
+	if _u.mutation.MovieCleared() && len(_u.mutation.MovieIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WatchEvent.movie"`)
+	}
+	return nil
+}
+
+func (_u *WatchEventUpdateOne) sqlSave(ctx context.Context) (_node *WatchEvent, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(watchevent.Table, watchevent.Columns, sqlgraph.NewFieldSpec(watchevent.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WatchEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, watchevent.FieldID)
+		for _, f := range fields {
+			if !watchevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != watchevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.WatchedAt(); ok {
+		_spec.SetField(watchevent.FieldWatchedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Rating(); ok {
+		_spec.SetField(watchevent.FieldRating, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRating(); ok {
+		_spec.AddField(watchevent.FieldRating, field.TypeInt, value)
+	}
+	if _u.mutation.RatingCleared() {
+		_spec.ClearField(watchevent.FieldRating, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(watchevent.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(watchevent.FieldNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.Location(); ok {
+		_spec.SetField(watchevent.FieldLocation, field.TypeString, value)
+	}
+	if _u.mutation.LocationCleared() {
+		_spec.ClearField(watchevent.FieldLocation, field.TypeString)
+	}
+	if _u.mutation.MovieCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   watchevent.MovieTable,
+			Columns: []string{watchevent.MovieColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(movie.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MovieIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   watchevent.MovieTable,
+			Columns: []string{watchevent.MovieColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(movie.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &WatchEvent{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{watchevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,76 @@
+package graph
+
+import (
+	"encoding/json"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// 1回のクエリで許容する複雑度
+// 各フィールドを 1 とし、一覧のフィールドは子フィールドの複雑度に件数を掛ける
+// （movies(first: 100) で作品の主なフィールドを取得できる程度）
+const MaxComplexity = 5000
+
+// first 引数で件数を指定する一覧フィールド（値は first の既定値）
+var paginatedFields = map[string]int{
+	"Query.movies":      20,
+	"Movie.watchEvents": 20,
+}
+
+// 件数を指定できない一覧フィールドの件数の見積もり
+var listEstimates = map[string]int{
+	"Movie.seasons":   5,
+	"Season.episodes": 20,
+}
+
+// 選択されたフィールドの複雑度の合計（上限を超えた時点で打ち切る）
+func complexity(set ast.SelectionSet, variables map[string]any) int {
+	total := 0
+	for _, sel := range set {
+		switch s := sel.(type) {
+		case *ast.Field:
+			total += fieldComplexity(s, variables)
+		case *ast.FragmentSpread:
+			total += complexity(s.Definition.SelectionSet, variables)
+		case *ast.InlineFragment:
+			total += complexity(s.SelectionSet, variables)
+		}
+		if total > MaxComplexity {
+			return total
+		}
+	}
+	return total
+}
+
+func fieldComplexity(f *ast.Field, variables map[string]any) int {
+	child := complexity(f.SelectionSet, variables)
+	if f.ObjectDefinition == nil {
+		return 1 + child
+	}
+
+	key := f.ObjectDefinition.Name + "." + f.Name
+	if first, ok := paginatedFields[key]; ok {
+		if n, ok := intArgument(f.ArgumentMap(variables)["first"]); ok {
+			first = n
+		}
+		return 1 + child*max(first, 1)
+	}
+	if n, ok := listEstimates[key]; ok {
+		return 1 + child*n
+	}
+	return 1 + child
+}
+
+// リテラル（int64）と変数（JSON の数値）のどちらの整数も受け付ける
+func intArgument(v any) (int, bool) {
+	switch n := v.(type) {
+	case int64:
+		return int(min(n, MaxComplexity+1)), true
+	case float64:
+		return int(min(n, MaxComplexity+1)), true
+	case json.Number:
+		i, err := n.Int64()
+		return int(min(i, MaxComplexity+1)), err == nil
+	}
+	return 0, false
+}
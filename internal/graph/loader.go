@@ -0,0 +1,57 @@
+package graph
+
+import (
+	"context"
+	"sync"
+
+	"watchlist-app/ent"
+	"watchlist-app/internal/service"
+)
+
+// 一覧の作品の視聴履歴をまとめて読み込む
+// 最初に参照された時点で一覧の全ての作品の分を1回のクエリで取得し、作品ごとの件数は SQL で絞り込む
+// 同じクエリ内で first の異なる watchEvents を選択した場合は first ごとに1回ずつ取得する
+type watchEventLoader struct {
+	service  *service.WatchEventService
+	movieIDs []int
+
+	mu      sync.Mutex
+	batches map[int]*watchEventBatch
+}
+
+type watchEventBatch struct {
+	once   sync.Once
+	events map[int][]*ent.WatchEvent
+	err    error
+}
+
+func newWatchEventLoader(s *service.WatchEventService, movies ...*ent.Movie) *watchEventLoader {
+	ids := make([]int, len(movies))
+	for i, m := range movies {
+		ids[i] = m.ID
+	}
+	return &watchEventLoader{
+		service:  s,
+		movieIDs: ids,
+		batches:  map[int]*watchEventBatch{},
+	}
+}
+
+// 作品の視聴履歴を新しい順に first 件まで返す
+func (l *watchEventLoader) load(ctx context.Context, userID, movieID, first int) ([]*ent.WatchEvent, error) {
+	l.mu.Lock()
+	batch, ok := l.batches[first]
+	if !ok {
+		batch = &watchEventBatch{}
+		l.batches[first] = batch
+	}
+	l.mu.Unlock()
+
+	batch.once.Do(func() {
+		batch.events, batch.err = l.service.GetLatestWatchEvents(ctx, userID, l.movieIDs, first)
+	})
+	if batch.err != nil {
+		return nil, batch.err
+	}
+	return batch.events[movieID], nil
+}
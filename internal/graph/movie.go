@@ -0,0 +1,313 @@
+package graph
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"time"
+
+	"watchlist-app/ent"
+	"watchlist-app/internal/service"
+	"watchlist-app/pkg/errors"
+	"watchlist-app/pkg/pagination"
+
+	"github.com/graph-gophers/graphql-go"
+)
+
+type movieConnectionResolver struct {
+	r    *Resolver
+	page *service.MoviePage
+}
+
+func (c *movieConnectionResolver) Edges() []*movieEdgeResolver {
+	// ページ内の作品で視聴履歴の読み込みを共有する
+	events := newWatchEventLoader(c.r.watchEventService, c.page.Movies...)
+	edges := make([]*movieEdgeResolver, len(c.page.Movies))
+	for i, m := range c.page.Movies {
+		edges[i] = &movieEdgeResolver{node: &movieResolver{r: c.r, m: m, events: events}, cursor: c.page.Cursors[i]}
+	}
+	return edges
+}
+
+func (c *movieConnectionResolver) PageInfo() *pageInfoResolver {
+	info := &pageInfoResolver{hasNextPage: c.page.HasMore}
+	if n := len(c.page.Cursors); n > 0 {
+		info.startCursor = &c.page.Cursors[0]
+		info.endCursor = &c.page.Cursors[n-1]
+	}
+	return info
+}
+
+func (c *movieConnectionResolver) TotalCount() int32 {
+	return int32(c.page.Total)
+}
+
+type movieEdgeResolver struct {
+	node   *movieResolver
+	cursor string
+}
+
+func (e *movieEdgeResolver) Node() *movieResolver {
+	return e.node
+}
+
+func (e *movieEdgeResolver) Cursor() string {
+	return e.cursor
+}
+
+// 前方向のページネーションのみ対応するため hasPreviousPage は常に false
+type pageInfoResolver struct {
+	hasNextPage            bool
+	startCursor, endCursor *string
+}
+
+func (p *pageInfoResolver) HasNextPage() bool {
+	return p.hasNextPage
+}
+
+func (p *pageInfoResolver) HasPreviousPage() bool {
+	return false
+}
+
+func (p *pageInfoResolver) StartCursor() *string {
+	return p.startCursor
+}
+
+func (p *pageInfoResolver) EndCursor() *string {
+	return p.endCursor
+}
+
+// 作品（タグ・シーズン・視聴履歴の ID はサービスで読み込み済み）
+type movieResolver struct {
+	r      *Resolver
+	m      *ent.Movie
+	events *watchEventLoader
+}
+
+// 一覧以外で返す作品
+func newMovieResolver(r *Resolver, m *ent.Movie) *movieResolver {
+	return &movieResolver{r: r, m: m, events: newWatchEventLoader(r.watchEventService, m)}
+}
+
+func (m *movieResolver) ID() graphql.ID {
+	return graphql.ID(strconv.Itoa(m.m.ID))
+}
+
+func (m *movieResolver) Title() string {
+	return m.m.Title
+}
+
+func (m *movieResolver) Description() *string {
+	return optionalString(m.m.Description)
+}
+
+func (m *movieResolver) Genre() *string {
+	return optionalString(m.m.Genre)
+}
+
+func (m *movieResolver) ReleaseYear() *int32 {
+	return optionalInt(m.m.ReleaseYear)
+}
+
+func (m *movieResolver) PosterURL() *string {
+	return optionalString(m.m.PosterURL)
+}
+
+func (m *movieResolver) MediaType() string {
+	return string(m.m.MediaType)
+}
+
+func (m *movieResolver) WatchStatus() string {
+	return string(m.m.WatchStatus)
+}
+
+func (m *movieResolver) Rating() *int32 {
+	return optionalInt(m.m.Rating)
+}
+
+func (m *movieResolver) Review() *string {
+	return optionalString(m.m.Review)
+}
+
+func (m *movieResolver) WatchedAt() *graphql.Time {
+	return optionalTime(m.m.WatchedAt)
+}
+
+func (m *movieResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: m.m.CreatedAt}
+}
+
+func (m *movieResolver) UpdatedAt() graphql.Time {
+	return graphql.Time{Time: m.m.UpdatedAt}
+}
+
+func (m *movieResolver) Version() int32 {
+	return int32(m.m.Version)
+}
+
+func (m *movieResolver) WatchCount() int32 {
+	return int32(len(m.m.Edges.WatchEvents))
+}
+
+func (m *movieResolver) RewatchCount() int32 {
+	return int32(max(len(m.m.Edges.WatchEvents)-1, 0))
+}
+
+func (m *movieResolver) Tags() []string {
+	names := make([]string, len(m.m.Edges.Tags))
+	for i, t := range m.m.Edges.Tags {
+		names[i] = t.Name
+	}
+	return names
+}
+
+func (m *movieResolver) Progress() *progressResolver {
+	if !service.IsSeriesMediaType(m.m.MediaType) {
+		return nil
+	}
+	watched, total := service.EpisodeProgress(m.m.Edges.Seasons)
+	return &progressResolver{watched: watched, total: total}
+}
+
+func (m *movieResolver) Seasons() []*seasonResolver {
+	seasons := make([]*seasonResolver, len(m.m.Edges.Seasons))
+	for i, s := range m.m.Edges.Seasons {
+		seasons[i] = &seasonResolver{s: s}
+	}
+	return seasons
+}
+
+// 視聴履歴は選択された場合のみ読み込む（一覧では全ての作品の分をまとめて読み込む）
+func (m *movieResolver) WatchEvents(ctx context.Context, args struct{ First int32 }) ([]*watchEventResolver, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if args.First < 0 || args.First > pagination.MaxLimit {
+		return nil, wrapError(errors.NewBadRequestError("first には 0〜100 を指定してください"))
+	}
+
+	events, err := m.events.load(ctx, userID, m.m.ID, int(args.First))
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	resolvers := make([]*watchEventResolver, len(events))
+	for i, e := range events {
+		resolvers[i] = &watchEventResolver{e: e}
+	}
+	return resolvers, nil
+}
+
+type progressResolver struct {
+	watched, total int
+}
+
+func (p *progressResolver) WatchedEpisodes() int32 {
+	return int32(p.watched)
+}
+
+func (p *progressResolver) TotalEpisodes() int32 {
+	return int32(p.total)
+}
+
+func (p *progressResolver) Percentage() float64 {
+	if p.total == 0 {
+		return 0
+	}
+	return math.Round(float64(p.watched)/float64(p.total)*1000) / 10
+}
+
+type seasonResolver struct {
+	s *ent.Season
+}
+
+func (s *seasonResolver) ID() graphql.ID {
+	return graphql.ID(strconv.Itoa(s.s.ID))
+}
+
+func (s *seasonResolver) Number() int32 {
+	return int32(s.s.Number)
+}
+
+func (s *seasonResolver) Title() *string {
+	return optionalString(s.s.Title)
+}
+
+func (s *seasonResolver) Episodes() []*episodeResolver {
+	episodes := make([]*episodeResolver, len(s.s.Edges.Episodes))
+	for i, e := range s.s.Edges.Episodes {
+		episodes[i] = &episodeResolver{e: e}
+	}
+	return episodes
+}
+
+type episodeResolver struct {
+	e *ent.Episode
+}
+
+func (e *episodeResolver) ID() graphql.ID {
+	return graphql.ID(strconv.Itoa(e.e.ID))
+}
+
+func (e *episodeResolver) Number() int32 {
+	return int32(e.e.Number)
+}
+
+func (e *episodeResolver) Title() *string {
+	return optionalString(e.e.Title)
+}
+
+func (e *episodeResolver) WatchedAt() *graphql.Time {
+	if e.e.WatchedAt == nil {
+		return nil
+	}
+	return &graphql.Time{Time: *e.e.WatchedAt}
+}
+
+type watchEventResolver struct {
+	e *ent.WatchEvent
+}
+
+func (w *watchEventResolver) ID() graphql.ID {
+	return graphql.ID(strconv.Itoa(w.e.ID))
+}
+
+func (w *watchEventResolver) WatchedAt() graphql.Time {
+	return graphql.Time{Time: w.e.WatchedAt}
+}
+
+func (w *watchEventResolver) Rating() *int32 {
+	return optionalInt(w.e.Rating)
+}
+
+func (w *watchEventResolver) Note() *string {
+	return optionalString(w.e.Note)
+}
+
+func (w *watchEventResolver) Location() *string {
+	return optionalString(w.e.Location)
+}
+
+// 未設定（ゼロ値）のフィールドは null にする
+func optionalString(v string) *string {
+	if v == "" {
+		return nil
+	}
+	return &v
+}
+
+func optionalInt(v int) *int32 {
+	if v == 0 {
+		return nil
+	}
+	n := int32(v)
+	return &n
+}
+
+func optionalTime(v time.Time) *graphql.Time {
+	if v.IsZero() {
+		return nil
+	}
+	return &graphql.Time{Time: v}
+}
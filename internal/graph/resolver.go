@@ -0,0 +1,278 @@
+package graph
+
+import (
+	"context"
+	"strconv"
+
+	"watchlist-app/dto"
+	"watchlist-app/internal/service"
+	"watchlist-app/pkg/auth"
+	"watchlist-app/pkg/errors"
+	"watchlist-app/pkg/pagination"
+	"watchlist-app/pkg/validator"
+
+	"github.com/graph-gophers/graphql-go"
+)
+
+// Query・Mutation のルート
+type Resolver struct {
+	movieService      *service.MovieService
+	watchEventService *service.WatchEventService
+	episodeService    *service.EpisodeService
+	validator         *validator.CustomValidator
+}
+
+type movieWhereInput struct {
+	Genre         *string
+	Status        *string
+	MediaType     *string
+	Tags          *[]string
+	TagMode       *string
+	Platform      *string
+	RatingMin     *int32
+	RatingMax     *int32
+	YearFrom      *int32
+	YearTo        *int32
+	WatchedAfter  *string
+	WatchedBefore *string
+	HasReview     *bool
+	Unrated       *bool
+}
+
+type createMovieInput struct {
+	Title       string
+	Description *string
+	Genre       *string
+	ReleaseYear *int32
+	PosterURL   *string
+	MediaType   string
+	Tags        *[]string
+}
+
+type updateMovieInput struct {
+	Title       *string
+	Description *string
+	Genre       *string
+	ReleaseYear *int32
+	PosterURL   *string
+	MediaType   *string
+	WatchStatus *string
+	Rating      *int32
+	Review      *string
+	Tags        *[]string
+}
+
+// 作品詳細
+func (r *Resolver) Movie(ctx context.Context, args struct{ ID graphql.ID }) (*movieResolver, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := r.movieService.GetMovie(ctx, userID, id)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	return newMovieResolver(r, m), nil
+}
+
+// 作品一覧（REST の一覧と同じ絞り込み・並び順・カーソル）
+func (r *Resolver) Movies(ctx context.Context, args struct {
+	First   int32
+	After   *string
+	Where   *movieWhereInput
+	Filter  *string
+	Search  *string
+	OrderBy *string
+}) (*movieConnectionResolver, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := dto.MovieFilter{
+		Filter: deref(args.Filter),
+		Query:  deref(args.Search),
+		Sort:   deref(args.OrderBy),
+		Cursor: deref(args.After),
+	}
+	if args.First < 1 || args.First > pagination.MaxLimit {
+		return nil, wrapError(errors.NewBadRequestError("first には 1〜100 を指定してください"))
+	}
+	filter.Limit = int(args.First)
+	if w := args.Where; w != nil {
+		filter.Genre = deref(w.Genre)
+		filter.Status = deref(w.Status)
+		filter.MediaType = deref(w.MediaType)
+		filter.TagMode = deref(w.TagMode)
+		filter.Platform = deref(w.Platform)
+		filter.RatingMin = int(deref(w.RatingMin))
+		filter.RatingMax = int(deref(w.RatingMax))
+		filter.YearFrom = int(deref(w.YearFrom))
+		filter.YearTo = int(deref(w.YearTo))
+		filter.WatchedAfter = deref(w.WatchedAfter)
+		filter.WatchedBefore = deref(w.WatchedBefore)
+		filter.HasReview = boolString(w.HasReview)
+		filter.Unrated = boolString(w.Unrated)
+		if w.Tags != nil {
+			filter.Tags = *w.Tags
+		}
+	}
+	if err := r.validator.Validate(&filter); err != nil {
+		return nil, wrapError(errors.NewBadRequestError("入力値が正しくありません: " + err.Error()))
+	}
+
+	page, err := r.movieService.GetMovies(ctx, userID, &filter)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	return &movieConnectionResolver{r: r, page: page}, nil
+}
+
+// 作品作成
+func (r *Resolver) CreateMovie(ctx context.Context, args struct{ Input createMovieInput }) (*movieResolver, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	in := args.Input
+	req := dto.CreateMovieRequest{
+		Title:       in.Title,
+		Description: deref(in.Description),
+		Genre:       deref(in.Genre),
+		ReleaseYear: int(deref(in.ReleaseYear)),
+		PosterURL:   deref(in.PosterURL),
+		MediaType:   in.MediaType,
+	}
+	if in.Tags != nil {
+		req.Tags = *in.Tags
+	}
+	if err := r.validator.Validate(&req); err != nil {
+		return nil, wrapError(errors.NewBadRequestError("入力値が正しくありません: " + err.Error()))
+	}
+
+	m, err := r.movieService.CreateMovie(ctx, userID, &req)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	return newMovieResolver(r, m), nil
+}
+
+// 作品更新（省略したフィールドは変更しない）
+func (r *Resolver) UpdateMovie(ctx context.Context, args struct {
+	ID      graphql.ID
+	Input   updateMovieInput
+	Version int32
+}) (*movieResolver, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	in := args.Input
+	req := dto.UpdateMovieRequest{
+		Title:       deref(in.Title),
+		Description: deref(in.Description),
+		Genre:       deref(in.Genre),
+		ReleaseYear: int(deref(in.ReleaseYear)),
+		PosterURL:   deref(in.PosterURL),
+		MediaType:   deref(in.MediaType),
+		WatchStatus: deref(in.WatchStatus),
+		Rating:      int(deref(in.Rating)),
+		Review:      deref(in.Review),
+	}
+	if in.Tags != nil {
+		// 空配列は「全て外す」なので nil にしない
+		req.Tags = append([]string{}, *in.Tags...)
+	}
+	if err := r.validator.Validate(&req); err != nil {
+		return nil, wrapError(errors.NewBadRequestError("入力値が正しくありません: " + err.Error()))
+	}
+
+	m, err := r.movieService.UpdateMovie(ctx, userID, id, &req, versions(args.Version))
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	return newMovieResolver(r, m), nil
+}
+
+// 作品削除（ゴミ箱に移動）
+func (r *Resolver) DeleteMovie(ctx context.Context, args struct {
+	ID      graphql.ID
+	Version int32
+}) (graphql.ID, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return "", err
+	}
+	id, err := parseID(args.ID)
+	if err != nil {
+		return "", err
+	}
+
+	if err := r.movieService.DeleteMovie(ctx, userID, id, versions(args.Version)); err != nil {
+		return "", wrapError(err)
+	}
+	return args.ID, nil
+}
+
+// AppError の HTTP ステータスを extensions.code として返すエラー
+type resolverError struct {
+	*errors.AppError
+}
+
+func (e resolverError) Extensions() map[string]any {
+	return map[string]any{"code": e.Code}
+}
+
+func wrapError(err error) error {
+	if appErr, ok := err.(*errors.AppError); ok {
+		return resolverError{appErr}
+	}
+	return err
+}
+
+func currentUserID(ctx context.Context) (int, error) {
+	userID, ok := auth.UserIDFromContext(ctx)
+	if !ok {
+		return 0, wrapError(errors.NewUnauthorizedError(""))
+	}
+	return userID, nil
+}
+
+func parseID(id graphql.ID) (int, error) {
+	n, err := strconv.Atoi(string(id))
+	if err != nil || n <= 0 {
+		return 0, wrapError(errors.NewBadRequestError("無効なIDです"))
+	}
+	return n, nil
+}
+
+// version 引数を If-Match と同じ形式にする
+func versions(version int32) service.IfMatch {
+	return service.IfMatch{Versions: []int{int(version)}}
+}
+
+func boolString(v *bool) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.FormatBool(*v)
+}
+
+func deref[T any](v *T) T {
+	if v == nil {
+		var zero T
+		return zero
+	}
+	return *v
+}
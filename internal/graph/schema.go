@@ -0,0 +1,80 @@
+// Package graph は作品を対象とした GraphQL API（POST /graphql）を提供する
+// Movie などの型は ent のスキーマの entgraphql アノテーションから ent.graphqls に生成し、
+// クエリ・ミューテーションと計算する値は schema.graphqls に定義する
+// 取得・更新は REST と同じサービスを通して行う
+package graph
+
+import (
+	"context"
+	_ "embed"
+
+	"watchlist-app/internal/service"
+	"watchlist-app/pkg/validator"
+
+	"github.com/graph-gophers/graphql-go"
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// ent のスキーマから生成した型（go generate ./ent で更新する）
+//
+//go:embed ent.graphqls
+var entSDL string
+
+//go:embed schema.graphqls
+var schemaSDL string
+
+var schemaSources = []*ast.Source{
+	{Name: "ent.graphqls", Input: entSDL},
+	{Name: "schema.graphqls", Input: schemaSDL},
+}
+
+// ネストできる深さの上限
+const maxDepth = 10
+
+type Schema struct {
+	schema *graphql.Schema
+	// 複雑度の計算に使う型情報
+	types *ast.Schema
+}
+
+func NewSchema(movieService *service.MovieService, watchEventService *service.WatchEventService, episodeService *service.EpisodeService) *Schema {
+	resolver := &Resolver{
+		movieService:      movieService,
+		watchEventService: watchEventService,
+		episodeService:    episodeService,
+		validator:         validator.New(),
+	}
+	return &Schema{
+		schema: graphql.MustParseSchema(entSDL+"\n"+schemaSDL, resolver,
+			graphql.MaxDepth(maxDepth),
+		),
+		types: gqlparser.MustLoadSchema(schemaSources...),
+	}
+}
+
+// クエリを実行する（複雑度が上限を超える場合は実行しない）
+func (s *Schema) Exec(ctx context.Context, query, operationName string, variables map[string]any) *graphql.Response {
+	doc, errs := gqlparser.LoadQuery(s.types, query)
+	if len(errs) > 0 {
+		response := &graphql.Response{}
+		for _, e := range errs {
+			qe := &gqlerrors.QueryError{Message: e.Message}
+			for _, l := range e.Locations {
+				qe.Locations = append(qe.Locations, gqlerrors.Location{Line: l.Line, Column: l.Column})
+			}
+			response.Errors = append(response.Errors, qe)
+		}
+		return response
+	}
+
+	if op := doc.Operations.ForName(operationName); op != nil {
+		if c := complexity(op.SelectionSet, variables); c > MaxComplexity {
+			return &graphql.Response{Errors: []*gqlerrors.QueryError{
+				gqlerrors.Errorf("クエリが複雑すぎます（複雑度 %d、上限 %d）。first を小さくするか、取得するフィールドを減らしてください", c, MaxComplexity),
+			}}
+		}
+	}
+	return s.schema.Exec(ctx, query, operationName, variables)
+}
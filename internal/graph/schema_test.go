@@ -0,0 +1,58 @@
+package graph
+
+import (
+	"testing"
+
+	"entgo.io/ent"
+	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/entc/load"
+
+	"watchlist-app/ent/schema"
+	"watchlist-app/pkg/entgraphql/sdl"
+)
+
+// ent/schema の全てのスキーマ（エッジの参照先を解決するため、GraphQL に公開しないものも含める）
+var entSchemas = []ent.Interface{
+	schema.AuditEntry{},
+	schema.Availability{},
+	schema.Credit{},
+	schema.Episode{},
+	schema.List{},
+	schema.ListEntry{},
+	schema.Movie{},
+	schema.Person{},
+	schema.Platform{},
+	schema.RefreshToken{},
+	schema.Season{},
+	schema.Tag{},
+	schema.User{},
+	schema.WatchEvent{},
+	schema.Webhook{},
+	schema.WebhookDelivery{},
+}
+
+// ent のスキーマを変更した後に go generate ./ent を実行していない場合は失敗する
+func TestGeneratedSchemaUpToDate(t *testing.T) {
+	schemas := make([]*load.Schema, len(entSchemas))
+	for i, s := range entSchemas {
+		b, err := load.MarshalSchema(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if schemas[i], err = load.UnmarshalSchema(b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	g, err := gen.NewGraph(&gen.Config{Package: "watchlist-app/ent"}, schemas...)
+	if err != nil {
+		t.Fatalf("ent のスキーマを読み込めません（entSchemas にスキーマを追加してください）: %v", err)
+	}
+
+	want, err := sdl.Render(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(want) != entSDL {
+		t.Errorf("ent.graphqls が ent のスキーマと一致しません。go generate ./ent を実行してください")
+	}
+}
@@ -0,0 +1,86 @@
+package grpcserver
+
+import (
+	"math"
+	"strings"
+	"time"
+
+	"watchlist-app/ent"
+	watchlistv1 "watchlist-app/gen/watchlist/v1"
+	"watchlist-app/internal/service"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Entエンティティ → protobuf メッセージへの変換
+func convertToMovie(m *ent.Movie) *watchlistv1.Movie {
+	movie := &watchlistv1.Movie{
+		Id:           int64(m.ID),
+		Title:        m.Title,
+		Description:  m.Description,
+		Genre:        m.Genre,
+		ReleaseYear:  int32(m.ReleaseYear),
+		PosterUrl:    m.PosterURL,
+		MediaType:    toMediaType(string(m.MediaType)),
+		WatchStatus:  toWatchStatus(string(m.WatchStatus)),
+		Rating:       int32(m.Rating),
+		Review:       m.Review,
+		WatchedAt:    optionalTimestamp(m.WatchedAt),
+		CreatedAt:    timestamppb.New(m.CreatedAt),
+		UpdatedAt:    timestamppb.New(m.UpdatedAt),
+		Version:      int64(m.Version),
+		WatchCount:   int32(len(m.Edges.WatchEvents)),
+		RewatchCount: int32(max(len(m.Edges.WatchEvents)-1, 0)),
+	}
+	for _, t := range m.Edges.Tags {
+		movie.Tags = append(movie.Tags, t.Name)
+	}
+
+	if service.IsSeriesMediaType(m.MediaType) {
+		watched, total := service.EpisodeProgress(m.Edges.Seasons)
+		movie.Progress = &watchlistv1.Progress{
+			WatchedEpisodes: int32(watched),
+			TotalEpisodes:   int32(total),
+		}
+		if total > 0 {
+			movie.Progress.Percentage = math.Round(float64(watched)/float64(total)*1000) / 10
+		}
+	}
+	return movie
+}
+
+// 列挙値は MEDIA_TYPE_TV_SERIES ⇔ tv_series のように接頭辞を除いた小文字が ent の値になる
+const (
+	mediaTypePrefix   = "MEDIA_TYPE_"
+	watchStatusPrefix = "WATCH_STATUS_"
+)
+
+// UNSPECIFIED は空文字（未指定）
+func mediaTypeString(t watchlistv1.MediaType) string {
+	if t == watchlistv1.MediaType_MEDIA_TYPE_UNSPECIFIED {
+		return ""
+	}
+	return strings.ToLower(strings.TrimPrefix(t.String(), mediaTypePrefix))
+}
+
+func watchStatusString(s watchlistv1.WatchStatus) string {
+	if s == watchlistv1.WatchStatus_WATCH_STATUS_UNSPECIFIED {
+		return ""
+	}
+	return strings.ToLower(strings.TrimPrefix(s.String(), watchStatusPrefix))
+}
+
+func toMediaType(v string) watchlistv1.MediaType {
+	return watchlistv1.MediaType(watchlistv1.MediaType_value[mediaTypePrefix+strings.ToUpper(v)])
+}
+
+func toWatchStatus(v string) watchlistv1.WatchStatus {
+	return watchlistv1.WatchStatus(watchlistv1.WatchStatus_value[watchStatusPrefix+strings.ToUpper(v)])
+}
+
+func optionalTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
@@ -0,0 +1,73 @@
+package grpcserver
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strings"
+
+	"watchlist-app/internal/service"
+	"watchlist-app/pkg/auth"
+	"watchlist-app/pkg/errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// AppError の HTTP ステータス → gRPC のステータスコード
+var statusCodes = map[int]codes.Code{
+	http.StatusBadRequest:           codes.InvalidArgument,
+	http.StatusUnauthorized:         codes.Unauthenticated,
+	http.StatusForbidden:            codes.PermissionDenied,
+	http.StatusNotFound:             codes.NotFound,
+	http.StatusConflict:             codes.AlreadyExists,
+	http.StatusPreconditionFailed:   codes.FailedPrecondition,
+	http.StatusPreconditionRequired: codes.FailedPrecondition,
+	http.StatusUnsupportedMediaType: codes.InvalidArgument,
+	http.StatusFailedDependency:     codes.Aborted,
+	http.StatusInternalServerError:  codes.Internal,
+}
+
+// AppError を gRPC のステータスに変換し、panic は Internal として返す
+func errorInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("panic in %s: %v", info.FullMethod, r)
+			err = status.Error(codes.Internal, "サーバー内部でエラーが発生しました")
+		}
+	}()
+
+	resp, err = handler(ctx, req)
+	if appErr, ok := err.(*errors.AppError); ok {
+		code, ok := statusCodes[appErr.Code]
+		if !ok {
+			code = codes.Unknown
+		}
+		return nil, status.Error(code, appErr.Message)
+	}
+	return resp, err
+}
+
+// metadata の authorization（Bearer トークン）でユーザーを識別し、コンテキストにユーザーIDを設定する
+func authInterceptor(authService *service.AuthService) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		var header string
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get("authorization"); len(values) > 0 {
+				header = values[0]
+			}
+		}
+		scheme, token, found := strings.Cut(header, " ")
+		if !found || !strings.EqualFold(scheme, "Bearer") || token == "" {
+			return nil, errors.NewUnauthorizedError("認証トークンが必要です")
+		}
+
+		userID, err := authService.VerifyAccessToken(strings.TrimSpace(token))
+		if err != nil {
+			return nil, err
+		}
+		return handler(auth.WithUserID(ctx, userID), req)
+	}
+}
@@ -0,0 +1,215 @@
+package grpcserver
+
+import (
+	"context"
+	"strconv"
+
+	"watchlist-app/dto"
+	watchlistv1 "watchlist-app/gen/watchlist/v1"
+	"watchlist-app/internal/service"
+	"watchlist-app/pkg/auth"
+	"watchlist-app/pkg/errors"
+	"watchlist-app/pkg/validator"
+)
+
+type movieServer struct {
+	watchlistv1.UnimplementedMovieServiceServer
+	movieService *service.MovieService
+	validator    *validator.CustomValidator
+}
+
+// 作品一覧取得
+func (s *movieServer) ListMovies(ctx context.Context, req *watchlistv1.ListMoviesRequest) (*watchlistv1.ListMoviesResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := dto.MovieFilter{
+		Genre:         req.GetGenre(),
+		Status:        watchStatusString(req.GetStatus()),
+		MediaType:     mediaTypeString(req.GetMediaType()),
+		Tags:          req.GetTags(),
+		TagMode:       req.GetTagMode(),
+		Platform:      req.GetPlatform(),
+		RatingMin:     int(req.GetRatingMin()),
+		RatingMax:     int(req.GetRatingMax()),
+		YearFrom:      int(req.GetYearFrom()),
+		YearTo:        int(req.GetYearTo()),
+		WatchedAfter:  req.GetWatchedAfter(),
+		WatchedBefore: req.GetWatchedBefore(),
+		Filter:        req.GetFilter(),
+		Query:         req.GetQuery(),
+		Sort:          req.GetSort(),
+		Limit:         int(req.GetPageSize()),
+		Cursor:        req.GetPageToken(),
+	}
+	if req.HasReview != nil {
+		filter.HasReview = strconv.FormatBool(req.GetHasReview())
+	}
+	if req.Unrated != nil {
+		filter.Unrated = strconv.FormatBool(req.GetUnrated())
+	}
+	if err := s.validator.Validate(&filter); err != nil {
+		return nil, errors.NewBadRequestError("入力値が正しくありません: " + err.Error())
+	}
+
+	page, err := s.movieService.GetMovies(ctx, userID, &filter)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &watchlistv1.ListMoviesResponse{
+		Movies:        make([]*watchlistv1.Movie, len(page.Movies)),
+		TotalCount:    int32(page.Total),
+		NextPageToken: page.NextCursor,
+	}
+	for i, m := range page.Movies {
+		response.Movies[i] = convertToMovie(m)
+	}
+	return response, nil
+}
+
+// 作品詳細取得
+func (s *movieServer) GetMovie(ctx context.Context, req *watchlistv1.GetMovieRequest) (*watchlistv1.Movie, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := s.movieService.GetMovie(ctx, userID, int(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	return convertToMovie(m), nil
+}
+
+// 作品作成
+func (s *movieServer) CreateMovie(ctx context.Context, req *watchlistv1.CreateMovieRequest) (*watchlistv1.Movie, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	create := dto.CreateMovieRequest{
+		Title:       req.GetTitle(),
+		Description: req.GetDescription(),
+		Genre:       req.GetGenre(),
+		ReleaseYear: int(req.GetReleaseYear()),
+		PosterURL:   req.GetPosterUrl(),
+		MediaType:   mediaTypeString(req.GetMediaType()),
+		Tags:        req.GetTags(),
+	}
+	if err := s.validator.Validate(&create); err != nil {
+		return nil, errors.NewBadRequestError("入力値が正しくありません: " + err.Error())
+	}
+
+	m, err := s.movieService.CreateMovie(ctx, userID, &create)
+	if err != nil {
+		return nil, err
+	}
+	return convertToMovie(m), nil
+}
+
+// 作品更新
+func (s *movieServer) UpdateMovie(ctx context.Context, req *watchlistv1.UpdateMovieRequest) (*watchlistv1.Movie, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	update := dto.UpdateMovieRequest{
+		Title:       req.GetTitle(),
+		Description: req.GetDescription(),
+		Genre:       req.GetGenre(),
+		ReleaseYear: int(req.GetReleaseYear()),
+		PosterURL:   req.GetPosterUrl(),
+		MediaType:   mediaTypeString(req.GetMediaType()),
+		WatchStatus: watchStatusString(req.GetWatchStatus()),
+		Rating:      int(req.GetRating()),
+		Review:      req.GetReview(),
+	}
+	if req.Tags != nil {
+		// 空のリストは「全て外す」なので nil にしない
+		update.Tags = append([]string{}, req.Tags.GetNames()...)
+	}
+	if err := s.validator.Validate(&update); err != nil {
+		return nil, errors.NewBadRequestError("入力値が正しくありません: " + err.Error())
+	}
+
+	ifMatch, err := versions(req.Version)
+	if err != nil {
+		return nil, err
+	}
+	m, err := s.movieService.UpdateMovie(ctx, userID, int(req.GetId()), &update, ifMatch)
+	if err != nil {
+		return nil, err
+	}
+	return convertToMovie(m), nil
+}
+
+// 作品削除（ゴミ箱に移動）
+func (s *movieServer) DeleteMovie(ctx context.Context, req *watchlistv1.DeleteMovieRequest) (*watchlistv1.DeleteMovieResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ifMatch, err := versions(req.Version)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.movieService.DeleteMovie(ctx, userID, int(req.GetId()), ifMatch); err != nil {
+		return nil, err
+	}
+	return &watchlistv1.DeleteMovieResponse{}, nil
+}
+
+// 視聴統計取得
+func (s *movieServer) GetWatchStats(ctx context.Context, _ *watchlistv1.GetWatchStatsRequest) (*watchlistv1.GetWatchStatsResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.movieService.GetWatchStats(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &watchlistv1.GetWatchStatsResponse{Counts: make(map[string]int32, len(stats))}
+	for status, count := range stats {
+		response.Counts[status] = int32(count)
+	}
+	return response, nil
+}
+
+// ジャンル一覧取得
+func (s *movieServer) GetGenres(ctx context.Context, _ *watchlistv1.GetGenresRequest) (*watchlistv1.GetGenresResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	genres, err := s.movieService.GetGenres(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &watchlistv1.GetGenresResponse{Genres: genres}, nil
+}
+
+func currentUserID(ctx context.Context) (int, error) {
+	userID, ok := auth.UserIDFromContext(ctx)
+	if !ok {
+		return 0, errors.NewUnauthorizedError("")
+	}
+	return userID, nil
+}
+
+// version を If-Match と同じ形式にする（未指定の場合は FailedPrecondition）
+func versions(version *int64) (service.IfMatch, error) {
+	if version == nil {
+		return service.IfMatch{}, errors.NewPreconditionRequiredError("version に取得時の作品のバージョンを指定してください")
+	}
+	return service.IfMatch{Versions: []int{int(*version)}}, nil
+}
@@ -0,0 +1,31 @@
+// Package grpcserver は REST と同じサービス層を使う gRPC サーバー（watchlist.v1.MovieService）を提供する
+package grpcserver
+
+import (
+	"watchlist-app/ent"
+	watchlistv1 "watchlist-app/gen/watchlist/v1"
+	"watchlist-app/internal/service"
+	"watchlist-app/pkg/auth"
+	"watchlist-app/pkg/config"
+	"watchlist-app/pkg/validator"
+
+	"google.golang.org/grpc"
+)
+
+func New(client *ent.Client, cfg *config.Config) *grpc.Server {
+	// サービス初期化（router.SetupRoutes と同じ構成）
+	tokenManager := auth.NewTokenManager(cfg.Auth.JWTSecret, cfg.Auth.AccessTokenTTL)
+	movieService := service.NewMovieService(client)
+	userService := service.NewUserService(client)
+	authService := service.NewAuthService(client, userService, tokenManager, cfg.Auth.RefreshTokenTTL)
+
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		errorInterceptor,
+		authInterceptor(authService),
+	))
+	watchlistv1.RegisterMovieServiceServer(server, &movieServer{
+		movieService: movieService,
+		validator:    validator.New(),
+	})
+	return server
+}
@@ -0,0 +1,126 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"watchlist-app/dto"
+	"watchlist-app/ent"
+	"watchlist-app/internal/service"
+	"watchlist-app/pkg/errors"
+
+	"github.com/labstack/echo/v4"
+)
+
+type AuditHandler struct {
+	auditService *service.AuditService
+	movieService *service.MovieService
+}
+
+func NewAuditHandler(auditService *service.AuditService, movieService *service.MovieService) *AuditHandler {
+	return &AuditHandler{
+		auditService: auditService,
+		movieService: movieService,
+	}
+}
+
+// GET /api/v1/movies/:id/history - 変更履歴取得
+func (h *AuditHandler) GetMovieHistory(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errors.NewBadRequestError("無効なIDです")
+	}
+
+	entries, err := h.auditService.GetMovieHistory(c.Request().Context(), userID, id)
+	if err != nil {
+		return err
+	}
+
+	response := make([]*dto.AuditEntryResponse, len(entries))
+	for i, e := range entries {
+		response[i] = convertToAuditEntryResponse(e, len(entries)-i)
+	}
+
+	return c.JSON(http.StatusOK, dto.AuditEntriesResponse{
+		Data:  response,
+		Count: len(response),
+	})
+}
+
+// GET /api/v1/movies/:id/revisions/diff?from=&to= - リビジョン間の差分取得
+func (h *AuditHandler) DiffRevisions(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errors.NewBadRequestError("無効なIDです")
+	}
+	from, err := strconv.Atoi(c.QueryParam("from"))
+	if err != nil {
+		return errors.NewBadRequestError("無効なリビジョンです")
+	}
+	to, err := strconv.Atoi(c.QueryParam("to"))
+	if err != nil {
+		return errors.NewBadRequestError("無効なリビジョンです")
+	}
+
+	changes, err := h.auditService.DiffRevisions(c.Request().Context(), userID, id, from, to)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, dto.RevisionDiffResponse{
+		From:    from,
+		To:      to,
+		Changes: changes,
+	})
+}
+
+// POST /api/v1/movies/:id/revisions/:rev/revert - 指定リビジョンの状態に戻す
+func (h *AuditHandler) RevertToRevision(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errors.NewBadRequestError("無効なIDです")
+	}
+	rev, err := strconv.Atoi(c.Param("rev"))
+	if err != nil {
+		return errors.NewBadRequestError("無効なリビジョンです")
+	}
+
+	if err := h.auditService.RevertToRevision(c.Request().Context(), userID, id, rev); err != nil {
+		return err
+	}
+
+	movie, err := h.movieService.GetMovie(c.Request().Context(), userID, id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, dto.MovieDetailResponse{
+		Data: convertToMovieResponse(movie),
+	})
+}
+
+// Entエンティティ → DTOレスポンスへの変換
+func convertToAuditEntryResponse(e *ent.AuditEntry, revision int) *dto.AuditEntryResponse {
+	return &dto.AuditEntryResponse{
+		ID:        e.ID,
+		Revision:  revision,
+		Action:    string(e.Action),
+		ActorID:   e.ActorID,
+		Changes:   e.Changes,
+		CreatedAt: e.CreatedAt,
+	}
+}
@@ -0,0 +1,170 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+	"watchlist-app/dto"
+	"watchlist-app/ent"
+	"watchlist-app/internal/service"
+	"watchlist-app/pkg/auth"
+	"watchlist-app/pkg/errors"
+
+	"github.com/labstack/echo/v4"
+)
+
+type AuthHandler struct {
+	userService *service.UserService
+	authService *service.AuthService
+}
+
+func NewAuthHandler(userService *service.UserService, authService *service.AuthService) *AuthHandler {
+	return &AuthHandler{
+		userService: userService,
+		authService: authService,
+	}
+}
+
+// POST /api/v1/auth/register - ユーザー登録
+func (h *AuthHandler) Register(c echo.Context) error {
+	var req dto.RegisterRequest
+	if err := c.Bind(&req); err != nil {
+		return errors.NewBadRequestError("リクエストの形式が正しくありません")
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return errors.NewBadRequestError("入力値が正しくありません: " + err.Error())
+	}
+
+	u, err := h.userService.Register(c.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, dto.UserDetailResponse{
+		Data: convertToUserResponse(u),
+	})
+}
+
+// POST /api/v1/auth/login - ログイン
+func (h *AuthHandler) Login(c echo.Context) error {
+	var req dto.LoginRequest
+	if err := c.Bind(&req); err != nil {
+		return errors.NewBadRequestError("リクエストの形式が正しくありません")
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return errors.NewBadRequestError("入力値が正しくありません: " + err.Error())
+	}
+
+	pair, err := h.authService.Login(c.Request().Context(), req.Email, req.Password)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, dto.TokenDetailResponse{
+		Data: convertToTokenResponse(pair),
+	})
+}
+
+// POST /api/v1/auth/refresh - トークン再発行
+func (h *AuthHandler) Refresh(c echo.Context) error {
+	var req dto.RefreshTokenRequest
+	if err := c.Bind(&req); err != nil {
+		return errors.NewBadRequestError("リクエストの形式が正しくありません")
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return errors.NewBadRequestError("入力値が正しくありません: " + err.Error())
+	}
+
+	pair, err := h.authService.Refresh(c.Request().Context(), req.RefreshToken)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, dto.TokenDetailResponse{
+		Data: convertToTokenResponse(pair),
+	})
+}
+
+// POST /api/v1/auth/logout - ログアウト（リフレッシュトークン失効）
+func (h *AuthHandler) Logout(c echo.Context) error {
+	var req dto.RefreshTokenRequest
+	if err := c.Bind(&req); err != nil {
+		return errors.NewBadRequestError("リクエストの形式が正しくありません")
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return errors.NewBadRequestError("入力値が正しくありません: " + err.Error())
+	}
+
+	if err := h.authService.Logout(c.Request().Context(), req.RefreshToken); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, dto.MessageResponse{
+		Message: "ログアウトしました",
+	})
+}
+
+// POST /api/v1/auth/logout/all - 全セッションからログアウト
+func (h *AuthHandler) LogoutAll(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	if err := h.authService.LogoutAll(c.Request().Context(), userID); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, dto.MessageResponse{
+		Message: "全てのセッションからログアウトしました",
+	})
+}
+
+// GET /api/v1/auth/me - ログインユーザー取得
+func (h *AuthHandler) Me(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	u, err := h.userService.GetUser(c.Request().Context(), userID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, dto.UserDetailResponse{
+		Data: convertToUserResponse(u),
+	})
+}
+
+// 認証ミドルウェアが設定したユーザーIDを取得
+func currentUserID(c echo.Context) (int, error) {
+	userID, ok := auth.UserIDFromContext(c.Request().Context())
+	if !ok {
+		return 0, errors.NewUnauthorizedError("")
+	}
+	return userID, nil
+}
+
+// Entエンティティ → DTOレスポンスへの変換
+func convertToUserResponse(u *ent.User) *dto.UserResponse {
+	return &dto.UserResponse{
+		ID:        u.ID,
+		Email:     u.Email,
+		Name:      u.Name,
+		CreatedAt: u.CreatedAt,
+	}
+}
+
+func convertToTokenResponse(pair *service.TokenPair) *dto.TokenResponse {
+	return &dto.TokenResponse{
+		AccessToken:  pair.AccessToken,
+		RefreshToken: pair.RefreshToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int(time.Until(pair.AccessExpiresAt).Seconds()),
+		User:         convertToUserResponse(pair.User),
+	}
+}
@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"net/http"
+	"watchlist-app/internal/openapi"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Swagger UI（静的ファイルは CDN から読み込む）
+const swaggerUIHTML = `<!DOCTYPE html>
+<html lang="ja">
+<head>
+  <meta charset="utf-8">
+  <title>Watchlist App API</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@5/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://unpkg.com/swagger-ui-dist@5/swagger-ui-bundle.js"></script>
+  <script>
+    window.ui = SwaggerUIBundle({
+      url: "/api/v1/openapi.json",
+      dom_id: "#swagger-ui",
+      persistAuthorization: true,
+    });
+  </script>
+</body>
+</html>
+`
+
+type DocsHandler struct {
+	spec *openapi.Document
+}
+
+func NewDocsHandler(spec *openapi.Document) *DocsHandler {
+	return &DocsHandler{
+		spec: spec,
+	}
+}
+
+// GET /api/v1/openapi.json - OpenAPI ドキュメント取得
+func (h *DocsHandler) Spec(c echo.Context) error {
+	return c.JSON(http.StatusOK, h.spec)
+}
+
+// GET /docs - API ドキュメント（Swagger UI）
+func (h *DocsHandler) UI(c echo.Context) error {
+	return c.HTML(http.StatusOK, swaggerUIHTML)
+}
@@ -0,0 +1,194 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"watchlist-app/dto"
+	"watchlist-app/ent"
+	"watchlist-app/internal/service"
+	"watchlist-app/pkg/errors"
+
+	"github.com/labstack/echo/v4"
+)
+
+type EpisodeHandler struct {
+	episodeService *service.EpisodeService
+}
+
+func NewEpisodeHandler(episodeService *service.EpisodeService) *EpisodeHandler {
+	return &EpisodeHandler{
+		episodeService: episodeService,
+	}
+}
+
+// GET /api/v1/movies/:id/seasons - シーズン一覧取得
+func (h *EpisodeHandler) GetSeasons(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errors.NewBadRequestError("無効なIDです")
+	}
+
+	seasons, err := h.episodeService.GetSeasons(c.Request().Context(), userID, id)
+	if err != nil {
+		return err
+	}
+
+	response := make([]*dto.SeasonResponse, len(seasons))
+	for i, s := range seasons {
+		response[i] = convertToSeasonResponse(s)
+	}
+
+	return c.JSON(http.StatusOK, dto.SeasonsResponse{
+		Data: response,
+	})
+}
+
+// POST /api/v1/movies/:id/seasons - シーズン作成
+func (h *EpisodeHandler) CreateSeason(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errors.NewBadRequestError("無効なIDです")
+	}
+
+	var req dto.CreateSeasonRequest
+	if err := c.Bind(&req); err != nil {
+		return errors.NewBadRequestError("リクエストの形式が正しくありません")
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return errors.NewBadRequestError("入力値が正しくありません: " + err.Error())
+	}
+
+	season, err := h.episodeService.CreateSeason(c.Request().Context(), userID, id, &req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, dto.SeasonDetailResponse{
+		Data: convertToSeasonResponse(season),
+	})
+}
+
+// DELETE /api/v1/movies/:id/seasons/:season_id - シーズン削除
+func (h *EpisodeHandler) DeleteSeason(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errors.NewBadRequestError("無効なIDです")
+	}
+
+	seasonID, err := strconv.Atoi(c.Param("season_id"))
+	if err != nil {
+		return errors.NewBadRequestError("無効なシーズンIDです")
+	}
+
+	if err := h.episodeService.DeleteSeason(c.Request().Context(), userID, id, seasonID); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, dto.MessageResponse{
+		Message: "シーズンが正常に削除されました",
+	})
+}
+
+// POST /api/v1/movies/:id/episodes/:episode_id/watch - エピソードを視聴済みにする
+func (h *EpisodeHandler) WatchEpisode(c echo.Context) error {
+	return h.setEpisodeWatched(c, true)
+}
+
+// DELETE /api/v1/movies/:id/episodes/:episode_id/watch - エピソードを未視聴に戻す
+func (h *EpisodeHandler) UnwatchEpisode(c echo.Context) error {
+	return h.setEpisodeWatched(c, false)
+}
+
+func (h *EpisodeHandler) setEpisodeWatched(c echo.Context, watched bool) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errors.NewBadRequestError("無効なIDです")
+	}
+
+	episodeID, err := strconv.Atoi(c.Param("episode_id"))
+	if err != nil {
+		return errors.NewBadRequestError("無効なエピソードIDです")
+	}
+
+	movie, err := h.episodeService.SetEpisodeWatched(c.Request().Context(), userID, id, episodeID, watched)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, dto.MovieDetailResponse{
+		Data: convertToMovieResponse(movie),
+	})
+}
+
+// POST /api/v1/movies/:id/episodes/watch - 指定話数までまとめて視聴済みにする
+func (h *EpisodeHandler) WatchEpisodesUpTo(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errors.NewBadRequestError("無効なIDです")
+	}
+
+	var req dto.WatchEpisodesRequest
+	if err := c.Bind(&req); err != nil {
+		return errors.NewBadRequestError("リクエストの形式が正しくありません")
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return errors.NewBadRequestError("入力値が正しくありません: " + err.Error())
+	}
+
+	movie, err := h.episodeService.WatchEpisodesUpTo(c.Request().Context(), userID, id, &req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, dto.MovieDetailResponse{
+		Data: convertToMovieResponse(movie),
+	})
+}
+
+// Entエンティティ → DTOレスポンスへの変換
+func convertToSeasonResponse(season *ent.Season) *dto.SeasonResponse {
+	episodes := make([]*dto.EpisodeResponse, len(season.Edges.Episodes))
+	for i, e := range season.Edges.Episodes {
+		episodes[i] = &dto.EpisodeResponse{
+			ID:        e.ID,
+			Number:    e.Number,
+			Title:     e.Title,
+			Watched:   e.WatchedAt != nil,
+			WatchedAt: e.WatchedAt,
+		}
+	}
+
+	return &dto.SeasonResponse{
+		ID:       season.ID,
+		Number:   season.Number,
+		Title:    season.Title,
+		Episodes: episodes,
+	}
+}
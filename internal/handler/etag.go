@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"strconv"
+	"strings"
+	"watchlist-app/ent"
+	"watchlist-app/internal/service"
+	"watchlist-app/pkg/errors"
+
+	"github.com/labstack/echo/v4"
+)
+
+// 作品の ETag（バージョンを引用符で囲んだ強いエンティティタグ）
+func movieETag(m *ent.Movie) string {
+	return strconv.Quote(strconv.Itoa(m.Version))
+}
+
+func setMovieETag(c echo.Context, m *ent.Movie) {
+	c.Response().Header().Set("ETag", movieETag(m))
+}
+
+// If-Match ヘッダーを作品の更新・削除の条件に変換する
+// 未指定の場合はサービスと同じく 428 とし、ヘッダーの指定方法を返す
+// 弱いエンティティタグ（W/"..."）は強い比較で一致しないため、それのみの場合は 412 とする
+func parseIfMatch(c echo.Context) (service.IfMatch, error) {
+	header := strings.TrimSpace(c.Request().Header.Get("If-Match"))
+	if header == "" {
+		return service.IfMatch{}, errors.NewPreconditionRequiredError("If-Match ヘッダーに取得時の ETag を指定してください")
+	}
+	if header == "*" {
+		return service.IfMatch{Any: true}, nil
+	}
+
+	var versions []int
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if strings.HasPrefix(tag, "W/") {
+			continue
+		}
+		unquoted, err := strconv.Unquote(tag)
+		if err != nil || !strings.HasPrefix(tag, `"`) {
+			return service.IfMatch{}, errors.NewBadRequestError("If-Match の形式が正しくありません")
+		}
+		version, err := strconv.Atoi(unquoted)
+		if err != nil {
+			// このAPIが発行していない ETag は一致しない
+			continue
+		}
+		versions = append(versions, version)
+	}
+	if len(versions) == 0 {
+		return service.IfMatch{}, errors.NewPreconditionFailedError("映画が他の操作で更新されています")
+	}
+	return service.IfMatch{Versions: versions}, nil
+}
@@ -0,0 +1,246 @@
+package handler
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"watchlist-app/dto"
+	"watchlist-app/ent"
+	"watchlist-app/internal/service"
+	"watchlist-app/pkg/csvimport"
+	"watchlist-app/pkg/errors"
+
+	"github.com/labstack/echo/v4"
+)
+
+// 何件ごとにクライアントへ送信するか
+const exportFlushInterval = 100
+
+type ExportHandler struct {
+	movieService *service.MovieService
+}
+
+func NewExportHandler(movieService *service.MovieService) *ExportHandler {
+	return &ExportHandler{
+		movieService: movieService,
+	}
+}
+
+// 形式ごとの Content-Type とファイル名
+var exportFormats = map[string]struct {
+	contentType, filename string
+}{
+	"csv":        {"text/csv; charset=utf-8", "watchlist.csv"},
+	"json":       {echo.MIMEApplicationJSONCharsetUTF8, "watchlist.json"},
+	"ndjson":     {"application/x-ndjson", "watchlist.ndjson"},
+	"letterboxd": {"text/csv; charset=utf-8", "letterboxd.csv"},
+}
+
+// GET /api/v1/export - 作品のエクスポート（絞り込み・並び順は一覧と同じ）
+// csv は source=watchlist、letterboxd は source=letterboxd でそのまま取り込める
+func (h *ExportHandler) Export(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	var req dto.ExportRequest
+	if err := c.Bind(&req); err != nil {
+		return errors.NewBadRequestError("クエリパラメータが正しくありません")
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return errors.NewBadRequestError("クエリパラメータが正しくありません: " + err.Error())
+	}
+
+	var w exportWriter
+	res := c.Response()
+	switch req.Format {
+	case "csv":
+		w = &csvExportWriter{w: csv.NewWriter(res), header: csvimport.WatchlistHeader, record: watchlistRecord}
+	case "letterboxd":
+		w = &csvExportWriter{w: csv.NewWriter(res), header: csvimport.LetterboxdHeader, record: letterboxdRecord}
+	case "json":
+		w = &jsonExportWriter{w: res}
+	case "ndjson":
+		w = &ndjsonExportWriter{enc: json.NewEncoder(res)}
+	}
+
+	// 一覧と同じ条件の誤り（範囲指定・filter など）はヘッダー送信前に 400 で返す
+	started := false
+	count := 0
+	err = h.movieService.ExportMovies(c.Request().Context(), userID, &req.MovieFilter, func(m *ent.Movie) error {
+		if !started {
+			if err := startExport(c, req.Format, w); err != nil {
+				return err
+			}
+			started = true
+		}
+		if err := w.write(m); err != nil {
+			return err
+		}
+		if count++; count%exportFlushInterval == 0 {
+			if err := w.flush(); err != nil {
+				return err
+			}
+			res.Flush()
+		}
+		return nil
+	})
+	if err != nil {
+		// 送信を始めた後のエラーはレスポンスを途中で打ち切る
+		return err
+	}
+	if !started {
+		if err := startExport(c, req.Format, w); err != nil {
+			return err
+		}
+	}
+	return w.close()
+}
+
+// レスポンスヘッダーと、形式ごとの先頭部分を送信する
+func startExport(c echo.Context, format string, w exportWriter) error {
+	f := exportFormats[format]
+	header := c.Response().Header()
+	header.Set(echo.HeaderContentType, f.contentType)
+	header.Set(echo.HeaderContentDisposition, `attachment; filename="`+f.filename+`"`)
+	c.Response().WriteHeader(http.StatusOK)
+	return w.open()
+}
+
+// エクスポート形式ごとの書き込み
+type exportWriter interface {
+	open() error
+	write(m *ent.Movie) error
+	flush() error
+	close() error
+}
+
+type csvExportWriter struct {
+	w      *csv.Writer
+	header []string
+	record func(m *ent.Movie) []string
+}
+
+func (e *csvExportWriter) open() error {
+	return e.w.Write(e.header)
+}
+
+func (e *csvExportWriter) write(m *ent.Movie) error {
+	return e.w.Write(e.record(m))
+}
+
+func (e *csvExportWriter) flush() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+func (e *csvExportWriter) close() error {
+	return e.flush()
+}
+
+// MovieResponse の配列として出力する
+type jsonExportWriter struct {
+	w     io.Writer
+	count int
+}
+
+func (e *jsonExportWriter) open() error {
+	_, err := io.WriteString(e.w, "[")
+	return err
+}
+
+func (e *jsonExportWriter) write(m *ent.Movie) error {
+	b, err := json.Marshal(convertToMovieResponse(m))
+	if err != nil {
+		return err
+	}
+	if e.count > 0 {
+		if _, err := io.WriteString(e.w, ","); err != nil {
+			return err
+		}
+	}
+	e.count++
+	_, err = e.w.Write(b)
+	return err
+}
+
+func (e *jsonExportWriter) flush() error {
+	return nil
+}
+
+func (e *jsonExportWriter) close() error {
+	_, err := io.WriteString(e.w, "]\n")
+	return err
+}
+
+// MovieResponse を1行に1件ずつ出力する
+type ndjsonExportWriter struct {
+	enc *json.Encoder
+}
+
+func (e *ndjsonExportWriter) open() error {
+	return nil
+}
+
+func (e *ndjsonExportWriter) write(m *ent.Movie) error {
+	return e.enc.Encode(convertToMovieResponse(m))
+}
+
+func (e *ndjsonExportWriter) flush() error {
+	return nil
+}
+
+func (e *ndjsonExportWriter) close() error {
+	return nil
+}
+
+// csvimport.WatchlistHeader の順の1行
+func watchlistRecord(m *ent.Movie) []string {
+	var watchedAt string
+	if !m.WatchedAt.IsZero() {
+		watchedAt = m.WatchedAt.Format(time.RFC3339)
+	}
+	return []string{
+		m.Title,
+		optionalInt(m.ReleaseYear),
+		string(m.MediaType),
+		string(m.WatchStatus),
+		optionalInt(m.Rating),
+		watchedAt,
+		m.Genre,
+		strings.Join(convertToTagNames(m), ","),
+		m.Description,
+		m.Review,
+		m.PosterURL,
+	}
+}
+
+// csvimport.LetterboxdHeader の順の1行（Letterboxd の取り込み形式）
+func letterboxdRecord(m *ent.Movie) []string {
+	var watchedDate string
+	if !m.WatchedAt.IsZero() {
+		watchedDate = m.WatchedAt.Format("2006-01-02")
+	}
+	return []string{
+		m.Title,
+		optionalInt(m.ReleaseYear),
+		optionalInt(m.Rating),
+		watchedDate,
+		strings.Join(convertToTagNames(m), ","),
+		m.Review,
+	}
+}
+
+// 未設定（0）の場合は空文字
+func optionalInt(v int) string {
+	if v == 0 {
+		return ""
+	}
+	return strconv.Itoa(v)
+}
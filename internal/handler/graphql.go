@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"net/http"
+	"watchlist-app/dto"
+	"watchlist-app/internal/graph"
+	"watchlist-app/pkg/errors"
+
+	"github.com/labstack/echo/v4"
+)
+
+type GraphQLHandler struct {
+	schema *graph.Schema
+}
+
+func NewGraphQLHandler(schema *graph.Schema) *GraphQLHandler {
+	return &GraphQLHandler{
+		schema: schema,
+	}
+}
+
+// POST /graphql - GraphQL クエリの実行
+// クエリのエラーも GraphQL の仕様に合わせて 200 で errors に含めて返す
+func (h *GraphQLHandler) Query(c echo.Context) error {
+	if _, err := currentUserID(c); err != nil {
+		return err
+	}
+
+	var req dto.GraphQLRequest
+	if err := c.Bind(&req); err != nil {
+		return errors.NewBadRequestError("リクエストの形式が正しくありません")
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return errors.NewBadRequestError("入力値が正しくありません: " + err.Error())
+	}
+
+	response := h.schema.Exec(c.Request().Context(), req.Query, req.OperationName, req.Variables)
+	return c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"net/http"
+	"watchlist-app/dto"
+	"watchlist-app/internal/service"
+	"watchlist-app/pkg/csvimport"
+	"watchlist-app/pkg/errors"
+
+	"github.com/labstack/echo/v4"
+)
+
+// アップロードできる CSV の最大サイズ
+const maxImportFileSize = 5 << 20
+
+type ImportHandler struct {
+	importService *service.ImportService
+}
+
+func NewImportHandler(importService *service.ImportService) *ImportHandler {
+	return &ImportHandler{
+		importService: importService,
+	}
+}
+
+// POST /api/v1/import - CSV インポート（既定はプレビューのみ、dry_run=false で取り込み）
+func (h *ImportHandler) Import(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	var req dto.ImportRequest
+	if err := c.Bind(&req); err != nil {
+		return errors.NewBadRequestError("リクエストの形式が正しくありません")
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return errors.NewBadRequestError("入力値が正しくありません: " + err.Error())
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		return errors.NewBadRequestError("CSV ファイルを file で指定してください")
+	}
+	if fileHeader.Size > maxImportFileSize {
+		return errors.NewBadRequestError("CSV ファイルは 5MB までです")
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		return errors.NewBadRequestError("CSV ファイルを読み込めません")
+	}
+	defer file.Close()
+
+	rows, err := csvimport.Parse(file, req.Source)
+	if err != nil {
+		return errors.NewBadRequestError(err.Error())
+	}
+
+	dryRun := req.DryRun != "false"
+	items, err := h.importService.Import(c.Request().Context(), userID, rows, service.ImportOptions{
+		DryRun:      dryRun,
+		WatchStatus: req.WatchStatus,
+	})
+	if err != nil {
+		return err
+	}
+
+	response := dto.ImportResponse{
+		Data:    make([]*dto.ImportItemResponse, len(items)),
+		Summary: &dto.ImportSummaryResponse{Total: len(items)},
+		DryRun:  dryRun,
+	}
+	for i, item := range items {
+		response.Data[i] = convertToImportItemResponse(item)
+		switch item.Status {
+		case service.ImportStatusNew:
+			response.Summary.New++
+		case service.ImportStatusDuplicate:
+			response.Summary.Duplicate++
+		case service.ImportStatusInvalid:
+			response.Summary.Invalid++
+		}
+		if item.MovieID > 0 {
+			response.Summary.Imported++
+		}
+	}
+
+	status := http.StatusOK
+	if response.Summary.Imported > 0 {
+		status = http.StatusCreated
+	}
+	return c.JSON(status, response)
+}
+
+// サービスの結果 → DTOレスポンスへの変換
+func convertToImportItemResponse(item *service.ImportItem) *dto.ImportItemResponse {
+	row := item.Row
+	response := &dto.ImportItemResponse{
+		Line:        row.Line,
+		Title:       row.Title,
+		ReleaseYear: row.ReleaseYear,
+		MediaType:   row.MediaType,
+		Rating:      row.Rating,
+		Review:      row.Review,
+		Status:      item.Status,
+		DuplicateOf: item.DuplicateOf,
+		MovieID:     item.MovieID,
+		Error:       row.Err,
+	}
+	if !row.WatchedAt.IsZero() {
+		watchedAt := row.WatchedAt
+		response.WatchedAt = &watchedAt
+	}
+	return response
+}
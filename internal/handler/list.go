@@ -0,0 +1,257 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"watchlist-app/dto"
+	"watchlist-app/ent"
+	"watchlist-app/internal/service"
+	"watchlist-app/pkg/errors"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ListHandler struct {
+	listService *service.ListService
+}
+
+func NewListHandler(listService *service.ListService) *ListHandler {
+	return &ListHandler{
+		listService: listService,
+	}
+}
+
+// GET /api/v1/lists - リスト一覧取得
+func (h *ListHandler) GetLists(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	lists, err := h.listService.GetLists(c.Request().Context(), userID)
+	if err != nil {
+		return err
+	}
+
+	response := make([]*dto.ListResponse, len(lists))
+	for i, l := range lists {
+		response[i] = convertToListResponse(l, false)
+	}
+
+	return c.JSON(http.StatusOK, dto.ListsResponse{
+		Data: response,
+	})
+}
+
+// GET /api/v1/lists/:id - リスト詳細取得（作品を含む）
+func (h *ListHandler) GetList(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errors.NewBadRequestError("無効なIDです")
+	}
+
+	l, err := h.listService.GetList(c.Request().Context(), userID, id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, dto.ListDetailResponse{
+		Data: convertToListResponse(l, true),
+	})
+}
+
+// POST /api/v1/lists - リスト作成
+func (h *ListHandler) CreateList(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	var req dto.ListRequest
+	if err := c.Bind(&req); err != nil {
+		return errors.NewBadRequestError("リクエストの形式が正しくありません")
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return errors.NewBadRequestError("入力値が正しくありません: " + err.Error())
+	}
+
+	l, err := h.listService.CreateList(c.Request().Context(), userID, &req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, dto.ListDetailResponse{
+		Data: convertToListResponse(l, true),
+	})
+}
+
+// PUT /api/v1/lists/:id - リスト更新
+func (h *ListHandler) UpdateList(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errors.NewBadRequestError("無効なIDです")
+	}
+
+	var req dto.ListRequest
+	if err := c.Bind(&req); err != nil {
+		return errors.NewBadRequestError("リクエストの形式が正しくありません")
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return errors.NewBadRequestError("入力値が正しくありません: " + err.Error())
+	}
+
+	l, err := h.listService.UpdateList(c.Request().Context(), userID, id, &req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, dto.ListDetailResponse{
+		Data: convertToListResponse(l, true),
+	})
+}
+
+// DELETE /api/v1/lists/:id - リスト削除
+func (h *ListHandler) DeleteList(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errors.NewBadRequestError("無効なIDです")
+	}
+
+	if err := h.listService.DeleteList(c.Request().Context(), userID, id); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, dto.MessageResponse{
+		Message: "リストが正常に削除されました",
+	})
+}
+
+// POST /api/v1/lists/:id/movies - リストに作品を追加
+func (h *ListHandler) AddMovie(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errors.NewBadRequestError("無効なIDです")
+	}
+
+	var req dto.AddListMovieRequest
+	if err := c.Bind(&req); err != nil {
+		return errors.NewBadRequestError("リクエストの形式が正しくありません")
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return errors.NewBadRequestError("入力値が正しくありません: " + err.Error())
+	}
+
+	l, err := h.listService.AddMovie(c.Request().Context(), userID, id, &req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, dto.ListDetailResponse{
+		Data: convertToListResponse(l, true),
+	})
+}
+
+// DELETE /api/v1/lists/:id/movies/:movie_id - リストから作品を外す
+func (h *ListHandler) RemoveMovie(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errors.NewBadRequestError("無効なIDです")
+	}
+
+	movieID, err := strconv.Atoi(c.Param("movie_id"))
+	if err != nil {
+		return errors.NewBadRequestError("無効な作品IDです")
+	}
+
+	l, err := h.listService.RemoveMovie(c.Request().Context(), userID, id, movieID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, dto.ListDetailResponse{
+		Data: convertToListResponse(l, true),
+	})
+}
+
+// PUT /api/v1/lists/:id/movies/order - リスト内の並び替え
+func (h *ListHandler) ReorderMovies(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errors.NewBadRequestError("無効なIDです")
+	}
+
+	var req dto.ReorderListRequest
+	if err := c.Bind(&req); err != nil {
+		return errors.NewBadRequestError("リクエストの形式が正しくありません")
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return errors.NewBadRequestError("入力値が正しくありません: " + err.Error())
+	}
+
+	l, err := h.listService.ReorderMovies(c.Request().Context(), userID, id, &req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, dto.ListDetailResponse{
+		Data: convertToListResponse(l, true),
+	})
+}
+
+// Entエンティティ → DTOレスポンスへの変換（withEntries が true の場合は作品を含める）
+func convertToListResponse(l *ent.List, withEntries bool) *dto.ListResponse {
+	response := &dto.ListResponse{
+		ID:          l.ID,
+		Name:        l.Name,
+		Description: l.Description,
+		MovieCount:  len(l.Edges.Entries),
+		CreatedAt:   l.CreatedAt,
+		UpdatedAt:   l.UpdatedAt,
+	}
+
+	if withEntries {
+		response.Entries = make([]*dto.ListEntryResponse, len(l.Edges.Entries))
+		for i, e := range l.Edges.Entries {
+			response.Entries[i] = &dto.ListEntryResponse{
+				Position: e.Position,
+				AddedAt:  e.AddedAt,
+				Movie:    convertToMovieResponse(e.Edges.Movie),
+			}
+		}
+	}
+	return response
+}
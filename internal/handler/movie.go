@@ -1,6 +1,9 @@
 package handler
 
 import (
+	"encoding/json"
+	"math"
+	"mime"
 	"net/http"
 	"strconv"
 	"watchlist-app/dto"
@@ -23,47 +26,69 @@ func NewMovieHandler(movieService *service.MovieService) *MovieHandler {
 
 // GET /api/v1/movies - 映画リスト取得
 func (h *MovieHandler) GetMovies(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
 	var filter dto.MovieFilter
 	if err := c.Bind(&filter); err != nil {
 		return errors.NewBadRequestError("クエリパラメータが正しくありません")
 	}
 
-	movies, err := h.movieService.GetMovies(c.Request().Context(), filter.Genre, filter.Status, filter.MediaType)
+	if err := c.Validate(&filter); err != nil {
+		return errors.NewBadRequestError("クエリパラメータが正しくありません: " + err.Error())
+	}
+
+	page, err := h.movieService.GetMovies(c.Request().Context(), userID, &filter)
 	if err != nil {
 		return err
 	}
 
 	// レスポンス変換
-	response := make([]*dto.MovieResponse, len(movies))
-	for i, movie := range movies {
-		response[i] = h.convertToMovieResponse(movie)
+	response := make([]*dto.MovieResponse, len(page.Movies))
+	for i, movie := range page.Movies {
+		response[i] = convertToMovieResponse(movie)
 	}
 
 	return c.JSON(http.StatusOK, dto.MoviesResponse{
-		Data:  response,
-		Count: len(response),
+		Data:       response,
+		Count:      page.Total,
+		NextCursor: page.NextCursor,
+		HasMore:    page.HasMore,
 	})
 }
 
 // GET /api/v1/movies/:id - 映画詳細取得
 func (h *MovieHandler) GetMovie(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
 		return errors.NewBadRequestError("無効なIDです")
 	}
 
-	movie, err := h.movieService.GetMovie(c.Request().Context(), id)
+	movie, err := h.movieService.GetMovie(c.Request().Context(), userID, id)
 	if err != nil {
 		return err
 	}
 
+	setMovieETag(c, movie)
 	return c.JSON(http.StatusOK, dto.MovieDetailResponse{
-		Data: h.convertToMovieResponse(movie),
+		Data: convertToMovieResponse(movie),
 	})
 }
 
 // POST /api/v1/movies - 映画作成
 func (h *MovieHandler) CreateMovie(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
 	var req dto.CreateMovieRequest
 	if err := c.Bind(&req); err != nil {
 		return errors.NewBadRequestError("リクエストの形式が正しくありません")
@@ -73,19 +98,24 @@ func (h *MovieHandler) CreateMovie(c echo.Context) error {
 		return errors.NewBadRequestError("入力値が正しくありません: " + err.Error())
 	}
 
-	movie, err := h.movieService.CreateMovie(c.Request().Context(), &req)
+	movie, err := h.movieService.CreateMovie(c.Request().Context(), userID, &req)
 
 	if err != nil {
 		return err
 	}
 
 	return c.JSON(http.StatusCreated, dto.MovieDetailResponse{
-		Data: h.convertToMovieResponse(movie),
+		Data: convertToMovieResponse(movie),
 	})
 }
 
 // PUT /api/v1/movies/:id - 映画更新
 func (h *MovieHandler) UpdateMovie(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
 		return errors.NewBadRequestError("無効なIDです")
@@ -100,37 +130,200 @@ func (h *MovieHandler) UpdateMovie(c echo.Context) error {
 		return errors.NewBadRequestError("入力値が正しくありません: " + err.Error())
 	}
 
-	movie, err := h.movieService.UpdateMovie(c.Request().Context(), id, &req)
+	ifMatch, err := parseIfMatch(c)
+	if err != nil {
+		return err
+	}
+
+	movie, err := h.movieService.UpdateMovie(c.Request().Context(), userID, id, &req, ifMatch)
+
+	if err != nil {
+		return err
+	}
+
+	setMovieETag(c, movie)
+	return c.JSON(http.StatusOK, dto.MovieDetailResponse{
+		Data: convertToMovieResponse(movie),
+	})
+}
+
+// PATCH /api/v1/movies/:id - 映画部分更新（JSON Merge Patch、null でフィールドをクリア）
+func (h *MovieHandler) PatchMovie(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errors.NewBadRequestError("無効なIDです")
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(c.Request().Header.Get(echo.HeaderContentType))
+	if mediaType != "application/merge-patch+json" && mediaType != echo.MIMEApplicationJSON {
+		return errors.NewAppError(http.StatusUnsupportedMediaType, "Content-Type には application/merge-patch+json を指定してください")
+	}
+
+	// パッチは JSON オブジェクトのみ受け付け、未知のフィールドは誤りとして扱う
+	var req dto.PatchMovieRequest
+	dec := json.NewDecoder(c.Request().Body)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&req); err != nil {
+		return errors.NewBadRequestError("リクエストの形式が正しくありません: " + err.Error())
+	}
+
+	ifMatch, err := parseIfMatch(c)
+	if err != nil {
+		return err
+	}
 
+	movie, err := h.movieService.PatchMovie(c.Request().Context(), userID, id, &req, ifMatch)
 	if err != nil {
 		return err
 	}
 
+	setMovieETag(c, movie)
 	return c.JSON(http.StatusOK, dto.MovieDetailResponse{
-		Data: h.convertToMovieResponse(movie),
+		Data: convertToMovieResponse(movie),
 	})
 }
 
-// DELETE /api/v1/movies/:id - 映画削除
+// DELETE /api/v1/movies/:id - 映画削除（ゴミ箱へ移動）
 func (h *MovieHandler) DeleteMovie(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
 		return errors.NewBadRequestError("無効なIDです")
 	}
 
-	err = h.movieService.DeleteMovie(c.Request().Context(), id)
+	ifMatch, err := parseIfMatch(c)
+	if err != nil {
+		return err
+	}
+
+	err = h.movieService.DeleteMovie(c.Request().Context(), userID, id, ifMatch)
 	if err != nil {
 		return err
 	}
 
 	return c.JSON(http.StatusOK, dto.MessageResponse{
-		Message: "映画が正常に削除されました",
+		Message: "映画をゴミ箱に移動しました",
 	})
 }
 
+// POST /api/v1/movies/bulk - 作品の一括作成・更新・削除
+func (h *MovieHandler) BulkMovies(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	var req dto.BulkMoviesRequest
+	if err := c.Bind(&req); err != nil {
+		return errors.NewBadRequestError("リクエストの形式が正しくありません")
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return errors.NewBadRequestError("入力値が正しくありません: " + err.Error())
+	}
+
+	ops := make([]*service.BulkOperation, len(req.Operations))
+	for i, opReq := range req.Operations {
+		ops[i] = parseBulkOperation(c, opReq)
+	}
+
+	partial := req.Mode == "partial"
+	results, err := h.movieService.BulkMovies(c.Request().Context(), userID, ops, partial)
+	if err != nil {
+		return err
+	}
+
+	// atomic で失敗した場合は、最初に失敗した操作のステータスを全体のステータスとする
+	status := http.StatusOK
+	response := dto.BulkMoviesResponse{Data: make([]*dto.BulkResultResponse, len(results))}
+	for i, r := range results {
+		item := &dto.BulkResultResponse{Index: i, Op: ops[i].Op, ID: r.ID}
+		if r.Err != nil {
+			item.Status, item.Error = errorStatus(r.Err)
+			response.Failed++
+			if !partial && status == http.StatusOK && item.Status != http.StatusFailedDependency {
+				status = item.Status
+			}
+		} else {
+			item.Status = http.StatusOK
+			if ops[i].Op == service.BulkOpCreate {
+				item.Status = http.StatusCreated
+			}
+			if r.Movie != nil {
+				item.Data = convertToMovieResponse(r.Movie)
+			}
+			response.Succeeded++
+		}
+		response.Data[i] = item
+	}
+	return c.JSON(status, response)
+}
+
+// 一括操作の1件分を検証してサービスの操作に変換する（検証エラーは操作の結果として返す）
+func parseBulkOperation(c echo.Context, req *dto.BulkOperationRequest) *service.BulkOperation {
+	op := &service.BulkOperation{Op: req.Op, ID: req.ID}
+	if req.Version > 0 {
+		op.IfMatch = []int{req.Version}
+	}
+
+	if req.Op != service.BulkOpCreate && req.ID <= 0 {
+		op.Err = errors.NewBadRequestError("id を指定してください")
+		return op
+	}
+	// 単体の PUT・PATCH・DELETE の If-Match と同じく、バージョンの指定がなければ 428 とする
+	if req.Op != service.BulkOpCreate && req.Version <= 0 {
+		op.Err = errors.NewPreconditionRequiredError("version に取得時の作品のバージョンを指定してください")
+		return op
+	}
+	switch req.Op {
+	case service.BulkOpCreate:
+		op.Create = &dto.CreateMovieRequest{}
+		op.Err = decodeBulkMovie(c, req.Movie, op.Create)
+	case service.BulkOpUpdate:
+		op.Update = &dto.UpdateMovieRequest{}
+		op.Err = decodeBulkMovie(c, req.Movie, op.Update)
+	}
+	return op
+}
+
+func decodeBulkMovie(c echo.Context, raw json.RawMessage, v any) error {
+	if len(raw) == 0 || string(raw) == "null" {
+		return errors.NewBadRequestError("movie を指定してください")
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		return errors.NewBadRequestError("movie の形式が正しくありません")
+	}
+	if err := c.Validate(v); err != nil {
+		return errors.NewBadRequestError("入力値が正しくありません: " + err.Error())
+	}
+	return nil
+}
+
+// エラーを HTTP ステータスとメッセージに変換する
+func errorStatus(err error) (int, string) {
+	if appErr, ok := err.(*errors.AppError); ok {
+		return appErr.Code, appErr.Message
+	}
+	return http.StatusInternalServerError, "サーバー内部でエラーが発生しました"
+}
+
 // GET /api/v1/stats/genres - ジャンル一覧取得
 func (h *MovieHandler) GetGenres(c echo.Context) error {
-	genres, err := h.movieService.GetGenres(c.Request().Context())
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	genres, err := h.movieService.GetGenres(c.Request().Context(), userID)
 	if err != nil {
 		return nil
 	}
@@ -139,7 +332,12 @@ func (h *MovieHandler) GetGenres(c echo.Context) error {
 
 // GET /api/v1/stats/watch - 視聴統計取得
 func (h *MovieHandler) GetWatchStats(c echo.Context) error {
-	stats, err := h.movieService.GetWatchStats(c.Request().Context())
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	stats, err := h.movieService.GetWatchStats(c.Request().Context(), userID)
 	if err != nil {
 		return nil
 	}
@@ -147,8 +345,8 @@ func (h *MovieHandler) GetWatchStats(c echo.Context) error {
 }
 
 // Entエンティティ → DTOレスポンスへの変換
-func (h *MovieHandler) convertToMovieResponse(movie *ent.Movie) *dto.MovieResponse {
-	return &dto.MovieResponse{
+func convertToMovieResponse(movie *ent.Movie) *dto.MovieResponse {
+	response := &dto.MovieResponse{
 		ID:          movie.ID,
 		Title:       movie.Title,
 		Description: movie.Description,
@@ -162,5 +360,53 @@ func (h *MovieHandler) convertToMovieResponse(movie *ent.Movie) *dto.MovieRespon
 		WatchedAt:   movie.WatchedAt,
 		CreatedAt:   movie.CreatedAt,
 		UpdatedAt:   movie.UpdatedAt,
+		DeletedAt:   movie.DeletedAt,
+		Version:     movie.Version,
+		Tags:        convertToTagNames(movie),
+		Progress:    convertToProgressResponse(movie),
+		Credits:     convertToCreditResponses(movie.Edges.Credits),
+		Platforms:   convertToAvailabilityResponses(movie.Edges.Availabilities),
+	}
+
+	// 視聴履歴が読み込まれている場合のみ視聴回数を設定
+	if events, err := movie.Edges.WatchEventsOrErr(); err == nil {
+		response.WatchCount = len(events)
+		response.RewatchCount = max(len(events)-1, 0)
+	}
+	return response
+}
+
+// 作品に紐づくタグ名（タグが読み込まれていない場合は nil）
+func convertToTagNames(movie *ent.Movie) []string {
+	tags, err := movie.Edges.TagsOrErr()
+	if err != nil {
+		return nil
+	}
+
+	names := make([]string, len(tags))
+	for i, t := range tags {
+		names[i] = t.Name
+	}
+	return names
+}
+
+// シリーズ作品の視聴進捗（シーズンが読み込まれていない場合は nil）
+func convertToProgressResponse(movie *ent.Movie) *dto.ProgressResponse {
+	if !service.IsSeriesMediaType(movie.MediaType) {
+		return nil
+	}
+	seasons, err := movie.Edges.SeasonsOrErr()
+	if err != nil {
+		return nil
+	}
+
+	watched, total := service.EpisodeProgress(seasons)
+	progress := &dto.ProgressResponse{
+		WatchedEpisodes: watched,
+		TotalEpisodes:   total,
+	}
+	if total > 0 {
+		progress.Percentage = math.Round(float64(watched)/float64(total)*1000) / 10
 	}
+	return progress
 }
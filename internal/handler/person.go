@@ -0,0 +1,326 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"watchlist-app/dto"
+	"watchlist-app/ent"
+	"watchlist-app/internal/service"
+	"watchlist-app/pkg/errors"
+
+	"github.com/labstack/echo/v4"
+)
+
+type PersonHandler struct {
+	personService *service.PersonService
+}
+
+func NewPersonHandler(personService *service.PersonService) *PersonHandler {
+	return &PersonHandler{
+		personService: personService,
+	}
+}
+
+// GET /api/v1/people?q= - 人物検索
+func (h *PersonHandler) GetPeople(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	var filter dto.PersonFilter
+	if err := c.Bind(&filter); err != nil {
+		return errors.NewBadRequestError("無効なフィルターパラメータです")
+	}
+
+	people, err := h.personService.GetPeople(c.Request().Context(), userID, &filter)
+	if err != nil {
+		return err
+	}
+
+	response := make([]*dto.PersonResponse, len(people))
+	for i, p := range people {
+		response[i] = convertToPersonResponse(p)
+	}
+
+	return c.JSON(http.StatusOK, dto.PeopleResponse{
+		Data: response,
+	})
+}
+
+// GET /api/v1/people/:id - 人物詳細取得
+func (h *PersonHandler) GetPerson(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errors.NewBadRequestError("無効なIDです")
+	}
+
+	p, err := h.personService.GetPerson(c.Request().Context(), userID, id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, dto.PersonDetailResponse{
+		Data: convertToPersonResponse(p),
+	})
+}
+
+// POST /api/v1/people - 人物作成
+func (h *PersonHandler) CreatePerson(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	var req dto.PersonRequest
+	if err := c.Bind(&req); err != nil {
+		return errors.NewBadRequestError("リクエストの形式が正しくありません")
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return errors.NewBadRequestError("入力値が正しくありません: " + err.Error())
+	}
+
+	p, err := h.personService.CreatePerson(c.Request().Context(), userID, &req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, dto.PersonDetailResponse{
+		Data: convertToPersonResponse(p),
+	})
+}
+
+// PUT /api/v1/people/:id - 人物名変更
+func (h *PersonHandler) UpdatePerson(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errors.NewBadRequestError("無効なIDです")
+	}
+
+	var req dto.PersonRequest
+	if err := c.Bind(&req); err != nil {
+		return errors.NewBadRequestError("リクエストの形式が正しくありません")
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return errors.NewBadRequestError("入力値が正しくありません: " + err.Error())
+	}
+
+	p, err := h.personService.UpdatePerson(c.Request().Context(), userID, id, &req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, dto.PersonDetailResponse{
+		Data: convertToPersonResponse(p),
+	})
+}
+
+// DELETE /api/v1/people/:id - 人物削除
+func (h *PersonHandler) DeletePerson(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errors.NewBadRequestError("無効なIDです")
+	}
+
+	if err := h.personService.DeletePerson(c.Request().Context(), userID, id); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, dto.MessageResponse{
+		Message: "人物が正常に削除されました",
+	})
+}
+
+// GET /api/v1/people/:id/movies - 人物の担当作品一覧取得
+func (h *PersonHandler) GetFilmography(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errors.NewBadRequestError("無効なIDです")
+	}
+
+	credits, err := h.personService.GetFilmography(c.Request().Context(), userID, id)
+	if err != nil {
+		return err
+	}
+
+	response := make([]*dto.FilmographyEntryResponse, len(credits))
+	for i, cr := range credits {
+		response[i] = &dto.FilmographyEntryResponse{
+			CreditID:  cr.ID,
+			Role:      string(cr.Role),
+			Character: cr.Character,
+			Movie:     convertToMovieResponse(cr.Edges.Movie),
+		}
+	}
+
+	return c.JSON(http.StatusOK, dto.FilmographyResponse{
+		Data:  response,
+		Count: len(response),
+	})
+}
+
+// GET /api/v1/movies/:id/credits - 作品のクレジット一覧取得
+func (h *PersonHandler) GetCredits(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errors.NewBadRequestError("無効なIDです")
+	}
+
+	credits, err := h.personService.GetCredits(c.Request().Context(), userID, id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, dto.CreditsResponse{
+		Data: convertToCreditResponses(credits),
+	})
+}
+
+// POST /api/v1/movies/:id/credits - 作品にクレジットを追加
+func (h *PersonHandler) AddCredit(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errors.NewBadRequestError("無効なIDです")
+	}
+
+	var req dto.CreditRequest
+	if err := c.Bind(&req); err != nil {
+		return errors.NewBadRequestError("リクエストの形式が正しくありません")
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return errors.NewBadRequestError("入力値が正しくありません: " + err.Error())
+	}
+
+	cr, err := h.personService.AddCredit(c.Request().Context(), userID, id, &req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, dto.CreditDetailResponse{
+		Data: convertToCreditResponse(cr),
+	})
+}
+
+// DELETE /api/v1/movies/:id/credits/:credit_id - 作品からクレジットを削除
+func (h *PersonHandler) DeleteCredit(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errors.NewBadRequestError("無効なIDです")
+	}
+
+	creditID, err := strconv.Atoi(c.Param("credit_id"))
+	if err != nil {
+		return errors.NewBadRequestError("無効なクレジットIDです")
+	}
+
+	if err := h.personService.DeleteCredit(c.Request().Context(), userID, id, creditID); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, dto.MessageResponse{
+		Message: "クレジットが正常に削除されました",
+	})
+}
+
+// GET /api/v1/stats/people?role=director - 人物別の視聴統計取得
+func (h *PersonHandler) GetPersonStats(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	var filter dto.PersonStatsFilter
+	if err := c.Bind(&filter); err != nil {
+		return errors.NewBadRequestError("無効なフィルターパラメータです")
+	}
+
+	if err := c.Validate(&filter); err != nil {
+		return errors.NewBadRequestError("入力値が正しくありません: " + err.Error())
+	}
+
+	stats, err := h.personService.GetPersonStats(c.Request().Context(), userID, &filter)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{"data": stats})
+}
+
+// Entエンティティ → DTOレスポンスへの変換
+func convertToPersonResponse(p *ent.Person) *dto.PersonResponse {
+	// 1つの作品で複数の役割を持つ場合も作品数は1と数える
+	movies := make(map[int]bool, len(p.Edges.Credits))
+	for _, cr := range p.Edges.Credits {
+		movies[cr.MovieID] = true
+	}
+
+	return &dto.PersonResponse{
+		ID:         p.ID,
+		Name:       p.Name,
+		MovieCount: len(movies),
+		CreatedAt:  p.CreatedAt,
+	}
+}
+
+func convertToCreditResponse(cr *ent.Credit) *dto.CreditResponse {
+	response := &dto.CreditResponse{
+		ID:        cr.ID,
+		PersonID:  cr.PersonID,
+		Role:      string(cr.Role),
+		Character: cr.Character,
+	}
+	if p := cr.Edges.Person; p != nil {
+		response.PersonName = p.Name
+	}
+	return response
+}
+
+// 作品に紐づくクレジット（読み込まれていない場合は nil）
+func convertToCreditResponses(credits []*ent.Credit) []*dto.CreditResponse {
+	if credits == nil {
+		return nil
+	}
+
+	response := make([]*dto.CreditResponse, len(credits))
+	for i, cr := range credits {
+		response[i] = convertToCreditResponse(cr)
+	}
+	return response
+}
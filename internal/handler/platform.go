@@ -0,0 +1,205 @@
+package handler
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+	"watchlist-app/dto"
+	"watchlist-app/ent"
+	"watchlist-app/internal/service"
+	"watchlist-app/pkg/errors"
+
+	"github.com/labstack/echo/v4"
+)
+
+type PlatformHandler struct {
+	platformService *service.PlatformService
+}
+
+func NewPlatformHandler(platformService *service.PlatformService) *PlatformHandler {
+	return &PlatformHandler{
+		platformService: platformService,
+	}
+}
+
+// GET /api/v1/platforms - 配信サービス一覧取得
+func (h *PlatformHandler) GetPlatforms(c echo.Context) error {
+	platforms, err := h.platformService.GetPlatforms(c.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	response := make([]*dto.PlatformResponse, len(platforms))
+	for i, p := range platforms {
+		response[i] = convertToPlatformResponse(p)
+	}
+
+	return c.JSON(http.StatusOK, dto.PlatformsResponse{
+		Data: response,
+	})
+}
+
+// POST /api/v1/platforms - 配信サービス追加
+func (h *PlatformHandler) CreatePlatform(c echo.Context) error {
+	var req dto.PlatformRequest
+	if err := c.Bind(&req); err != nil {
+		return errors.NewBadRequestError("リクエストの形式が正しくありません")
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return errors.NewBadRequestError("入力値が正しくありません: " + err.Error())
+	}
+
+	p, err := h.platformService.CreatePlatform(c.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, dto.PlatformDetailResponse{
+		Data: convertToPlatformResponse(p),
+	})
+}
+
+// GET /api/v1/platforms/:slug/leaving?days=7 - 配信終了予定の作品一覧取得
+func (h *PlatformHandler) GetLeaving(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	var filter dto.LeavingFilter
+	if err := c.Bind(&filter); err != nil {
+		return errors.NewBadRequestError("クエリパラメータが正しくありません")
+	}
+
+	if err := c.Validate(&filter); err != nil {
+		return errors.NewBadRequestError("クエリパラメータが正しくありません: " + err.Error())
+	}
+
+	availabilities, err := h.platformService.GetLeaving(c.Request().Context(), userID, c.Param("slug"), &filter)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	response := make([]*dto.LeavingMovieResponse, len(availabilities))
+	for i, a := range availabilities {
+		response[i] = &dto.LeavingMovieResponse{
+			AvailableUntil: *a.AvailableUntil,
+			DaysLeft:       int(math.Ceil(a.AvailableUntil.Sub(now).Hours() / 24)),
+			Movie:          convertToMovieResponse(a.Edges.Movie),
+		}
+	}
+
+	return c.JSON(http.StatusOK, dto.LeavingMoviesResponse{
+		Data:  response,
+		Count: len(response),
+	})
+}
+
+// GET /api/v1/movies/:id/availability - 作品の配信状況取得
+func (h *PlatformHandler) GetAvailabilities(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errors.NewBadRequestError("無効なIDです")
+	}
+
+	availabilities, err := h.platformService.GetAvailabilities(c.Request().Context(), userID, id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, dto.AvailabilitiesResponse{
+		Data: convertToAvailabilityResponses(availabilities),
+	})
+}
+
+// PUT /api/v1/movies/:id/availability/:platform - 作品の配信期間を登録・更新
+func (h *PlatformHandler) SetAvailability(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errors.NewBadRequestError("無効なIDです")
+	}
+
+	var req dto.AvailabilityRequest
+	if err := c.Bind(&req); err != nil {
+		return errors.NewBadRequestError("リクエストの形式が正しくありません")
+	}
+
+	a, err := h.platformService.SetAvailability(c.Request().Context(), userID, id, c.Param("platform"), &req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, dto.AvailabilityDetailResponse{
+		Data: convertToAvailabilityResponse(a, time.Now()),
+	})
+}
+
+// DELETE /api/v1/movies/:id/availability/:platform - 作品の配信状況を削除
+func (h *PlatformHandler) DeleteAvailability(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errors.NewBadRequestError("無効なIDです")
+	}
+
+	if err := h.platformService.DeleteAvailability(c.Request().Context(), userID, id, c.Param("platform")); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, dto.MessageResponse{
+		Message: "配信状況が正常に削除されました",
+	})
+}
+
+// Entエンティティ → DTOレスポンスへの変換
+func convertToPlatformResponse(p *ent.Platform) *dto.PlatformResponse {
+	return &dto.PlatformResponse{
+		ID:   p.ID,
+		Slug: p.Slug,
+		Name: p.Name,
+	}
+}
+
+func convertToAvailabilityResponse(a *ent.Availability, now time.Time) *dto.AvailabilityResponse {
+	response := &dto.AvailabilityResponse{
+		AvailableFrom:  a.AvailableFrom,
+		AvailableUntil: a.AvailableUntil,
+		Available:      service.IsAvailableAt(a, now),
+	}
+	if p := a.Edges.Platform; p != nil {
+		response.Platform = p.Slug
+		response.PlatformName = p.Name
+	}
+	return response
+}
+
+// 作品の配信状況（読み込まれていない場合は nil）
+func convertToAvailabilityResponses(availabilities []*ent.Availability) []*dto.AvailabilityResponse {
+	if availabilities == nil {
+		return nil
+	}
+
+	now := time.Now()
+	response := make([]*dto.AvailabilityResponse, len(availabilities))
+	for i, a := range availabilities {
+		response[i] = convertToAvailabilityResponse(a, now)
+	}
+	return response
+}
@@ -0,0 +1,154 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"watchlist-app/dto"
+	"watchlist-app/ent"
+	"watchlist-app/internal/service"
+	"watchlist-app/pkg/errors"
+
+	"github.com/labstack/echo/v4"
+)
+
+type TagHandler struct {
+	tagService *service.TagService
+}
+
+func NewTagHandler(tagService *service.TagService) *TagHandler {
+	return &TagHandler{
+		tagService: tagService,
+	}
+}
+
+// GET /api/v1/tags - タグ一覧取得
+func (h *TagHandler) GetTags(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	tags, err := h.tagService.GetTags(c.Request().Context(), userID)
+	if err != nil {
+		return err
+	}
+
+	response := make([]*dto.TagResponse, len(tags))
+	for i, t := range tags {
+		response[i] = convertToTagResponse(t)
+	}
+
+	return c.JSON(http.StatusOK, dto.TagsResponse{
+		Data: response,
+	})
+}
+
+// GET /api/v1/tags/:id - タグ詳細取得
+func (h *TagHandler) GetTag(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errors.NewBadRequestError("無効なIDです")
+	}
+
+	t, err := h.tagService.GetTag(c.Request().Context(), userID, id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, dto.TagDetailResponse{
+		Data: convertToTagResponse(t),
+	})
+}
+
+// POST /api/v1/tags - タグ作成
+func (h *TagHandler) CreateTag(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	var req dto.TagRequest
+	if err := c.Bind(&req); err != nil {
+		return errors.NewBadRequestError("リクエストの形式が正しくありません")
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return errors.NewBadRequestError("入力値が正しくありません: " + err.Error())
+	}
+
+	t, err := h.tagService.CreateTag(c.Request().Context(), userID, &req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, dto.TagDetailResponse{
+		Data: convertToTagResponse(t),
+	})
+}
+
+// PUT /api/v1/tags/:id - タグ名変更
+func (h *TagHandler) UpdateTag(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errors.NewBadRequestError("無効なIDです")
+	}
+
+	var req dto.TagRequest
+	if err := c.Bind(&req); err != nil {
+		return errors.NewBadRequestError("リクエストの形式が正しくありません")
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return errors.NewBadRequestError("入力値が正しくありません: " + err.Error())
+	}
+
+	t, err := h.tagService.UpdateTag(c.Request().Context(), userID, id, &req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, dto.TagDetailResponse{
+		Data: convertToTagResponse(t),
+	})
+}
+
+// DELETE /api/v1/tags/:id - タグ削除
+func (h *TagHandler) DeleteTag(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errors.NewBadRequestError("無効なIDです")
+	}
+
+	if err := h.tagService.DeleteTag(c.Request().Context(), userID, id); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, dto.MessageResponse{
+		Message: "タグが正常に削除されました",
+	})
+}
+
+// Entエンティティ → DTOレスポンスへの変換
+func convertToTagResponse(t *ent.Tag) *dto.TagResponse {
+	return &dto.TagResponse{
+		ID:         t.ID,
+		Name:       t.Name,
+		MovieCount: len(t.Edges.Movies),
+		CreatedAt:  t.CreatedAt,
+	}
+}
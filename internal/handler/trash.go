@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"watchlist-app/dto"
+	"watchlist-app/internal/service"
+	"watchlist-app/pkg/errors"
+
+	"github.com/labstack/echo/v4"
+)
+
+type TrashHandler struct {
+	trashService *service.TrashService
+	movieService *service.MovieService
+}
+
+func NewTrashHandler(trashService *service.TrashService, movieService *service.MovieService) *TrashHandler {
+	return &TrashHandler{
+		trashService: trashService,
+		movieService: movieService,
+	}
+}
+
+// GET /api/v1/trash - ゴミ箱の作品一覧取得
+func (h *TrashHandler) GetTrash(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	movies, err := h.trashService.GetTrash(c.Request().Context(), userID)
+	if err != nil {
+		return err
+	}
+
+	response := make([]*dto.MovieResponse, len(movies))
+	for i, movie := range movies {
+		response[i] = convertToMovieResponse(movie)
+	}
+
+	return c.JSON(http.StatusOK, dto.MoviesResponse{
+		Data:  response,
+		Count: len(response),
+	})
+}
+
+// POST /api/v1/movies/:id/restore - ゴミ箱から作品を復元
+func (h *TrashHandler) Restore(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errors.NewBadRequestError("無効なIDです")
+	}
+
+	if err := h.trashService.Restore(c.Request().Context(), userID, id); err != nil {
+		return err
+	}
+
+	movie, err := h.movieService.GetMovie(c.Request().Context(), userID, id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, dto.MovieDetailResponse{
+		Data: convertToMovieResponse(movie),
+	})
+}
+
+// DELETE /api/v1/trash/:id - ゴミ箱の作品を完全に削除
+func (h *TrashHandler) Purge(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errors.NewBadRequestError("無効なIDです")
+	}
+
+	if err := h.trashService.Purge(c.Request().Context(), userID, id); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, dto.MessageResponse{
+		Message: "映画が完全に削除されました",
+	})
+}
@@ -0,0 +1,119 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"watchlist-app/dto"
+	"watchlist-app/ent"
+	"watchlist-app/internal/service"
+	"watchlist-app/pkg/errors"
+
+	"github.com/labstack/echo/v4"
+)
+
+type WatchEventHandler struct {
+	watchEventService *service.WatchEventService
+}
+
+func NewWatchEventHandler(watchEventService *service.WatchEventService) *WatchEventHandler {
+	return &WatchEventHandler{
+		watchEventService: watchEventService,
+	}
+}
+
+// GET /api/v1/movies/:id/watches - 視聴履歴取得
+func (h *WatchEventHandler) GetWatchEvents(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errors.NewBadRequestError("無効なIDです")
+	}
+
+	events, err := h.watchEventService.GetWatchEvents(c.Request().Context(), userID, id)
+	if err != nil {
+		return err
+	}
+
+	response := make([]*dto.WatchEventResponse, len(events))
+	for i, e := range events {
+		response[i] = convertToWatchEventResponse(e)
+	}
+
+	return c.JSON(http.StatusOK, dto.WatchEventsResponse{
+		Data:  response,
+		Count: len(response),
+	})
+}
+
+// POST /api/v1/movies/:id/watches - 視聴記録追加
+func (h *WatchEventHandler) CreateWatchEvent(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errors.NewBadRequestError("無効なIDです")
+	}
+
+	var req dto.CreateWatchEventRequest
+	if err := c.Bind(&req); err != nil {
+		return errors.NewBadRequestError("リクエストの形式が正しくありません")
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return errors.NewBadRequestError("入力値が正しくありません: " + err.Error())
+	}
+
+	event, err := h.watchEventService.CreateWatchEvent(c.Request().Context(), userID, id, &req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, dto.WatchEventDetailResponse{
+		Data: convertToWatchEventResponse(event),
+	})
+}
+
+// DELETE /api/v1/movies/:id/watches/:watch_id - 視聴記録削除
+func (h *WatchEventHandler) DeleteWatchEvent(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errors.NewBadRequestError("無効なIDです")
+	}
+
+	eventID, err := strconv.Atoi(c.Param("watch_id"))
+	if err != nil {
+		return errors.NewBadRequestError("無効な視聴記録IDです")
+	}
+
+	if err := h.watchEventService.DeleteWatchEvent(c.Request().Context(), userID, id, eventID); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, dto.MessageResponse{
+		Message: "視聴記録が正常に削除されました",
+	})
+}
+
+// Entエンティティ → DTOレスポンスへの変換
+func convertToWatchEventResponse(e *ent.WatchEvent) *dto.WatchEventResponse {
+	return &dto.WatchEventResponse{
+		ID:        e.ID,
+		WatchedAt: e.WatchedAt,
+		Rating:    e.Rating,
+		Note:      e.Note,
+		Location:  e.Location,
+		CreatedAt: e.CreatedAt,
+	}
+}
@@ -0,0 +1,272 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"watchlist-app/dto"
+	"watchlist-app/ent"
+	"watchlist-app/internal/service"
+	"watchlist-app/pkg/errors"
+
+	"github.com/labstack/echo/v4"
+)
+
+type WebhookHandler struct {
+	webhookService *service.WebhookService
+}
+
+func NewWebhookHandler(webhookService *service.WebhookService) *WebhookHandler {
+	return &WebhookHandler{
+		webhookService: webhookService,
+	}
+}
+
+// GET /api/v1/webhooks - Webhook 一覧取得
+func (h *WebhookHandler) GetWebhooks(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	hooks, err := h.webhookService.GetWebhooks(c.Request().Context(), userID)
+	if err != nil {
+		return err
+	}
+
+	response := make([]*dto.WebhookResponse, len(hooks))
+	for i, wh := range hooks {
+		response[i] = convertToWebhookResponse(wh)
+	}
+
+	return c.JSON(http.StatusOK, dto.WebhooksResponse{
+		Data: response,
+	})
+}
+
+// GET /api/v1/webhooks/:id - Webhook 詳細取得
+func (h *WebhookHandler) GetWebhook(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errors.NewBadRequestError("無効なIDです")
+	}
+
+	wh, err := h.webhookService.GetWebhook(c.Request().Context(), userID, id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, dto.WebhookDetailResponse{
+		Data: convertToWebhookResponse(wh),
+	})
+}
+
+// POST /api/v1/webhooks - Webhook 作成（署名用のシークレットはこのレスポンスでのみ返す）
+func (h *WebhookHandler) CreateWebhook(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	var req dto.WebhookRequest
+	if err := c.Bind(&req); err != nil {
+		return errors.NewBadRequestError("リクエストの形式が正しくありません")
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return errors.NewBadRequestError("入力値が正しくありません: " + err.Error())
+	}
+
+	wh, err := h.webhookService.CreateWebhook(c.Request().Context(), userID, &req)
+	if err != nil {
+		return err
+	}
+
+	response := convertToWebhookResponse(wh)
+	response.Secret = wh.Secret
+	return c.JSON(http.StatusCreated, dto.WebhookDetailResponse{
+		Data: response,
+	})
+}
+
+// PUT /api/v1/webhooks/:id - Webhook 更新
+func (h *WebhookHandler) UpdateWebhook(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errors.NewBadRequestError("無効なIDです")
+	}
+
+	var req dto.WebhookRequest
+	if err := c.Bind(&req); err != nil {
+		return errors.NewBadRequestError("リクエストの形式が正しくありません")
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return errors.NewBadRequestError("入力値が正しくありません: " + err.Error())
+	}
+
+	wh, err := h.webhookService.UpdateWebhook(c.Request().Context(), userID, id, &req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, dto.WebhookDetailResponse{
+		Data: convertToWebhookResponse(wh),
+	})
+}
+
+// DELETE /api/v1/webhooks/:id - Webhook 削除
+func (h *WebhookHandler) DeleteWebhook(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errors.NewBadRequestError("無効なIDです")
+	}
+
+	if err := h.webhookService.DeleteWebhook(c.Request().Context(), userID, id); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, dto.MessageResponse{
+		Message: "Webhook が正常に削除されました",
+	})
+}
+
+// GET /api/v1/webhooks/:id/deliveries?status=failed - 配信履歴取得
+func (h *WebhookHandler) GetDeliveries(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errors.NewBadRequestError("無効なIDです")
+	}
+
+	var filter dto.WebhookDeliveryFilter
+	if err := c.Bind(&filter); err != nil {
+		return errors.NewBadRequestError("クエリパラメータが正しくありません")
+	}
+
+	if err := c.Validate(&filter); err != nil {
+		return errors.NewBadRequestError("クエリパラメータが正しくありません: " + err.Error())
+	}
+
+	deliveries, err := h.webhookService.GetDeliveries(c.Request().Context(), userID, id, &filter)
+	if err != nil {
+		return err
+	}
+
+	response := make([]*dto.WebhookDeliveryResponse, len(deliveries))
+	for i, d := range deliveries {
+		response[i] = convertToWebhookDeliveryResponse(d, false)
+	}
+
+	return c.JSON(http.StatusOK, dto.WebhookDeliveriesResponse{
+		Data:  response,
+		Count: len(response),
+	})
+}
+
+// GET /api/v1/webhooks/:id/deliveries/:delivery_id - 配信履歴詳細取得（送信した本文を含む）
+func (h *WebhookHandler) GetDelivery(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errors.NewBadRequestError("無効なIDです")
+	}
+
+	deliveryID, err := strconv.Atoi(c.Param("delivery_id"))
+	if err != nil {
+		return errors.NewBadRequestError("無効な配信IDです")
+	}
+
+	d, err := h.webhookService.GetDelivery(c.Request().Context(), userID, id, deliveryID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, dto.WebhookDeliveryDetailResponse{
+		Data: convertToWebhookDeliveryResponse(d, true),
+	})
+}
+
+// POST /api/v1/webhooks/:id/deliveries/:delivery_id/redeliver - 配信を同じ本文で再送
+func (h *WebhookHandler) Redeliver(c echo.Context) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errors.NewBadRequestError("無効なIDです")
+	}
+
+	deliveryID, err := strconv.Atoi(c.Param("delivery_id"))
+	if err != nil {
+		return errors.NewBadRequestError("無効な配信IDです")
+	}
+
+	d, err := h.webhookService.Redeliver(c.Request().Context(), userID, id, deliveryID)
+	if err != nil {
+		return err
+	}
+
+	// 送信は非同期に行う
+	return c.JSON(http.StatusAccepted, dto.WebhookDeliveryDetailResponse{
+		Data: convertToWebhookDeliveryResponse(d, true),
+	})
+}
+
+// Entエンティティ → DTOレスポンスへの変換
+func convertToWebhookResponse(wh *ent.Webhook) *dto.WebhookResponse {
+	return &dto.WebhookResponse{
+		ID:        wh.ID,
+		URL:       wh.URL,
+		Events:    wh.Events,
+		Active:    wh.Active,
+		CreatedAt: wh.CreatedAt,
+		UpdatedAt: wh.UpdatedAt,
+	}
+}
+
+func convertToWebhookDeliveryResponse(d *ent.WebhookDelivery, withPayload bool) *dto.WebhookDeliveryResponse {
+	response := &dto.WebhookDeliveryResponse{
+		ID:             d.ID,
+		WebhookID:      d.WebhookID,
+		Event:          d.Event,
+		MovieID:        d.MovieID,
+		Status:         string(d.Status),
+		Attempts:       d.Attempts,
+		NextAttemptAt:  d.NextAttemptAt,
+		ResponseStatus: d.ResponseStatus,
+		LastError:      d.LastError,
+		DeliveredAt:    d.DeliveredAt,
+		RedeliveryOf:   d.RedeliveryOf,
+		CreatedAt:      d.CreatedAt,
+	}
+	if withPayload {
+		response.Payload = json.RawMessage(d.Payload)
+	}
+	return response
+}
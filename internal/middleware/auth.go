@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"strings"
+	"watchlist-app/internal/service"
+	"watchlist-app/pkg/auth"
+	"watchlist-app/pkg/errors"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Bearer トークン（JWT）でユーザーを識別し、リクエストコンテキストにユーザーIDを設定する
+func JWTAuth(authService *service.AuthService) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			header := c.Request().Header.Get(echo.HeaderAuthorization)
+			scheme, token, found := strings.Cut(header, " ")
+			if !found || !strings.EqualFold(scheme, "Bearer") || token == "" {
+				return errors.NewUnauthorizedError("認証トークンが必要です")
+			}
+
+			userID, err := authService.VerifyAccessToken(strings.TrimSpace(token))
+			if err != nil {
+				return err
+			}
+
+			ctx := auth.WithUserID(c.Request().Context(), userID)
+			c.SetRequest(c.Request().WithContext(ctx))
+			return next(c)
+		}
+	}
+}
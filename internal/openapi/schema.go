@@ -0,0 +1,227 @@
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"watchlist-app/dto"
+)
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	rawMessageType = reflect.TypeOf(json.RawMessage{})
+	patchFieldPkg  = reflect.TypeOf(dto.PatchField[string]{}).PkgPath()
+)
+
+// Go の型から JSON Schema を生成し、名前付きの構造体は components に登録する
+type schemaGenerator struct {
+	schemas map[string]*Schema
+	types   map[string]reflect.Type
+}
+
+func newSchemaGenerator() *schemaGenerator {
+	return &schemaGenerator{
+		schemas: map[string]*Schema{},
+		types:   map[string]reflect.Type{},
+	}
+}
+
+func (g *schemaGenerator) schemaFor(t reflect.Type) *Schema {
+	switch {
+	case t == timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case t == rawMessageType:
+		// 任意の JSON 値
+		return &Schema{}
+	case isPatchField(t):
+		// JSON Merge Patch のフィールドは null でクリアできる
+		f, _ := t.FieldByName("Value")
+		return nullable(g.schemaFor(f.Type))
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		// 構造体へのポインタは参照のまま、値へのポインタは null を許可する
+		if t.Elem().Kind() == reflect.Struct && t.Elem() != timeType {
+			return g.schemaFor(t.Elem())
+		}
+		return nullable(g.schemaFor(t.Elem()))
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int64, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		return &Schema{Type: "array", Items: g.schemaFor(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: g.schemaFor(t.Elem())}
+	case reflect.Interface:
+		return &Schema{}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return g.objectSchema(t, "json")
+		}
+		return g.ref(t)
+	}
+	panic(fmt.Sprintf("openapi: unsupported type %s", t))
+}
+
+// 名前付きの構造体を components に登録して参照を返す
+func (g *schemaGenerator) ref(t reflect.Type) *Schema {
+	name := t.Name()
+	if registered, ok := g.types[name]; ok {
+		if registered != t {
+			panic(fmt.Sprintf("openapi: schema name %s is used by %s and %s", name, registered, t))
+		}
+	} else {
+		g.types[name] = t
+		// 再帰的な型に備えて先に登録しておく
+		g.schemas[name] = &Schema{}
+		*g.schemas[name] = *g.objectSchema(t, "json")
+	}
+	return &Schema{Ref: "#/components/schemas/" + name}
+}
+
+// 構造体のフィールドを指定したタグ（json / query / form）の名前でプロパティにする
+func (g *schemaGenerator) objectSchema(t reflect.Type, tagKey string) *Schema {
+	s := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	g.eachField(t, tagKey, func(name string, f reflect.StructField, fs *Schema, required bool) {
+		s.Properties[name] = fs
+		if required {
+			s.Required = append(s.Required, name)
+		}
+	})
+	return s
+}
+
+// 埋め込み構造体を展開しながら、タグの付いたフィールドを順に処理する
+func (g *schemaGenerator) eachField(t reflect.Type, tagKey string, fn func(name string, f reflect.StructField, s *Schema, required bool)) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+
+		tag, ok := f.Tag.Lookup(tagKey)
+		if f.Anonymous && !ok && f.Type.Kind() == reflect.Struct {
+			g.eachField(f.Type, tagKey, fn)
+			continue
+		}
+
+		name, _, _ := strings.Cut(tag, ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			// json 以外はタグのないフィールドをバインドしない
+			if tagKey != "json" {
+				continue
+			}
+			name = f.Name
+		}
+
+		s := g.schemaFor(f.Type)
+		required := applyValidation(s, f.Tag.Get("validate"))
+		fn(name, f, s, required)
+	}
+}
+
+// validate タグの制約をスキーマに反映し、必須かどうかを返す
+// dive 以降の制約は配列の要素に適用する
+func applyValidation(s *Schema, tag string) bool {
+	if tag == "" {
+		return false
+	}
+
+	rules := strings.Split(tag, ",")
+	required := false
+	omitempty := false
+	target := s
+	for _, rule := range rules {
+		name, param, _ := strings.Cut(rule, "=")
+		switch name {
+		case "omitempty":
+			if target == s {
+				omitempty = true
+			}
+		case "required":
+			if target == s {
+				required = true
+			}
+		case "dive":
+			target = target.Items
+			if target == nil {
+				return required
+			}
+		case "oneof":
+			values := strings.Fields(param)
+			target.Enum = make([]any, len(values))
+			for i, v := range values {
+				if n, err := strconv.Atoi(v); err == nil && target.Type == "integer" {
+					target.Enum[i] = n
+				} else {
+					target.Enum[i] = v
+				}
+			}
+			// 空文字は oneof を満たさないため、omitempty がなければ必須になる
+			if target == s && !omitempty {
+				required = true
+			}
+		case "min", "max":
+			n, err := strconv.Atoi(param)
+			if err != nil {
+				continue
+			}
+			setBound(target, name == "min", n)
+		case "email":
+			target.Format = "email"
+		case "url", "http_url":
+			target.Format = "uri"
+		}
+	}
+	return required
+}
+
+func setBound(s *Schema, min bool, n int) {
+	switch s.Type {
+	case "string":
+		if min {
+			s.MinLength = &n
+		} else {
+			s.MaxLength = &n
+		}
+	case "array":
+		if min {
+			s.MinItems = &n
+		} else {
+			s.MaxItems = &n
+		}
+	case "integer", "number":
+		f := float64(n)
+		if min {
+			s.Minimum = &f
+		} else {
+			s.Maximum = &f
+		}
+	}
+}
+
+func nullable(s *Schema) *Schema {
+	if s.Ref != "" {
+		return &Schema{AllOf: []*Schema{s}, Nullable: true}
+	}
+	s.Nullable = true
+	return s
+}
+
+func isPatchField(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && t.PkgPath() == patchFieldPkg && strings.HasPrefix(t.Name(), "PatchField[")
+}
@@ -0,0 +1,384 @@
+package openapi
+
+import (
+	"net/http"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"watchlist-app/dto"
+)
+
+const (
+	mimeJSON      = "application/json"
+	mimeMultipart = "multipart/form-data"
+
+	securitySchemeName = "bearerAuth"
+)
+
+// 1ルート分の定義
+type route struct {
+	method, path string
+	id           string
+	tag          string
+	summary      string
+	description  string
+	// 認証不要のルート
+	public bool
+
+	// クエリパラメータの構造体（query タグ）とパラメータの説明
+	query  any
+	params map[string]string
+	// JSON のリクエストボディ（bodyType で Content-Type を変更できる）
+	body     any
+	bodyType string
+	// multipart/form-data の構造体（form タグ）とファイルのフィールド名
+	form  any
+	files []string
+	// If-Match による楽観的排他制御に対応する
+	ifMatch bool
+
+	// 成功時のステータス（省略時は 200）とレスポンス
+	statuses []int
+	response any
+	// JSON 以外の形式のレスポンス（Content-Type → 値の型、nil は文字列）
+	content map[string]any
+	// レスポンスに ETag ヘッダーを含む
+	etag bool
+	// 自動で付与するもの以外のエラーステータス
+	errors []int
+}
+
+// 一覧・エクスポートで共通の絞り込みパラメータの説明
+var movieFilterParams = map[string]string{
+	"genre":          "ジャンル",
+	"status":         "視聴ステータス（want_to_watch / watching / completed / dropped）",
+	"media_type":     "メディアタイプ（movie / tv_series / documentary / anime）",
+	"tag":            "タグ名（複数指定可）",
+	"tag_mode":       "複数タグの条件（既定は and）",
+	"platform":       "現在配信中の配信サービスのスラッグ",
+	"watched_after":  "視聴完了日時の下限（2024-01-31 形式の日付または RFC3339 形式の日時、境界を含む）",
+	"watched_before": "視聴完了日時の上限（境界を含まない）",
+	"filter":         "フィルタ式（例: genre:SF rating>=4 year:2010..2020 -media_type:anime）",
+	"q":              "タイトル・概要・レビューのキーワード検索（空白区切りで AND）",
+	"sort":           "並び順（カンマ区切り、先頭の - で降順。既定は -created_at）",
+	"limit":          "1ページの件数（既定 20）",
+	"cursor":         "前のページの next_cursor",
+}
+
+// stats の各エンドポイントは data のみを返す
+type (
+	genresResponse struct {
+		Data []string `json:"data"`
+	}
+	watchStatsResponse struct {
+		// 視聴ステータス → 件数
+		Data map[string]int `json:"data"`
+	}
+	personStatsResponse struct {
+		Data []*dto.PersonStatResponse `json:"data"`
+	}
+	healthResponse struct {
+		Status string `json:"status"`
+		Time   string `json:"time"`
+	}
+	graphQLError struct {
+		Message    string         `json:"message"`
+		Path       []any          `json:"path,omitempty"`
+		Extensions map[string]any `json:"extensions,omitempty"`
+	}
+	graphQLResponse struct {
+		Data   map[string]any  `json:"data"`
+		Errors []*graphQLError `json:"errors,omitempty"`
+	}
+)
+
+var routes = []route{
+	{method: http.MethodGet, path: "/health", id: "health", tag: "system", summary: "ヘルスチェック", public: true, response: healthResponse{}},
+	{method: http.MethodGet, path: "/api/v1/openapi.json", id: "getOpenAPI", tag: "system", summary: "OpenAPI ドキュメント取得", public: true, response: map[string]any{}},
+	{method: http.MethodGet, path: "/docs", id: "getDocs", tag: "system", summary: "API ドキュメント（Swagger UI）", public: true, content: map[string]any{"text/html": nil}},
+
+	// 認証
+	{method: http.MethodPost, path: "/api/v1/auth/register", id: "register", tag: "auth", summary: "ユーザー登録", public: true, body: dto.RegisterRequest{}, statuses: []int{http.StatusCreated}, response: dto.UserDetailResponse{}, errors: []int{http.StatusConflict}},
+	{method: http.MethodPost, path: "/api/v1/auth/login", id: "login", tag: "auth", summary: "ログイン", public: true, body: dto.LoginRequest{}, response: dto.TokenDetailResponse{}, errors: []int{http.StatusUnauthorized}},
+	{method: http.MethodPost, path: "/api/v1/auth/refresh", id: "refreshToken", tag: "auth", summary: "トークン再発行", description: "使用したリフレッシュトークンは失効し、新しいリフレッシュトークンを返します。", public: true, body: dto.RefreshTokenRequest{}, response: dto.TokenDetailResponse{}, errors: []int{http.StatusUnauthorized}},
+	{method: http.MethodPost, path: "/api/v1/auth/logout", id: "logout", tag: "auth", summary: "ログアウト（リフレッシュトークン失効）", public: true, body: dto.RefreshTokenRequest{}, response: dto.MessageResponse{}},
+	{method: http.MethodPost, path: "/api/v1/auth/logout/all", id: "logoutAll", tag: "auth", summary: "全セッションからログアウト", response: dto.MessageResponse{}},
+	{method: http.MethodGet, path: "/api/v1/auth/me", id: "getMe", tag: "auth", summary: "ログインユーザー取得", response: dto.UserDetailResponse{}},
+
+	// 作品
+	{method: http.MethodGet, path: "/api/v1/movies", id: "listMovies", tag: "movies", summary: "作品一覧取得", description: "カーソル方式でページ分割します。count は条件に一致する全件数です。", query: dto.MovieFilter{}, params: movieFilterParams, response: dto.MoviesResponse{}},
+	{method: http.MethodPost, path: "/api/v1/movies", id: "createMovie", tag: "movies", summary: "作品作成", body: dto.CreateMovieRequest{}, statuses: []int{http.StatusCreated}, response: dto.MovieDetailResponse{}},
+	{method: http.MethodPost, path: "/api/v1/movies/bulk", id: "bulkMovies", tag: "movies", summary: "作品の一括作成・更新・削除", description: "mode=atomic（既定）は1件でも失敗すると全て取り消し、最初に失敗した操作のステータスで結果を返します。mode=partial は成功した操作のみ反映します。update・delete は version（取得時のバージョン）が必須で、省略した操作は 428 になります。", body: dto.BulkMoviesRequest{}, response: dto.BulkMoviesResponse{}},
+	{method: http.MethodGet, path: "/api/v1/movies/{id}", id: "getMovie", tag: "movies", summary: "作品詳細取得", response: dto.MovieDetailResponse{}, etag: true},
+	{method: http.MethodPut, path: "/api/v1/movies/{id}", id: "updateMovie", tag: "movies", summary: "作品更新", body: dto.UpdateMovieRequest{}, ifMatch: true, response: dto.MovieDetailResponse{}, etag: true},
+	{method: http.MethodPatch, path: "/api/v1/movies/{id}", id: "patchMovie", tag: "movies", summary: "作品部分更新（JSON Merge Patch）", description: "指定したフィールドのみ更新し、null でフィールドをクリアします。", body: dto.PatchMovieRequest{}, bodyType: "application/merge-patch+json", ifMatch: true, response: dto.MovieDetailResponse{}, etag: true, errors: []int{http.StatusUnsupportedMediaType}},
+	{method: http.MethodDelete, path: "/api/v1/movies/{id}", id: "deleteMovie", tag: "movies", summary: "作品削除（ゴミ箱へ移動）", ifMatch: true, response: dto.MessageResponse{}},
+	{method: http.MethodPost, path: "/api/v1/movies/{id}/restore", id: "restoreMovie", tag: "trash", summary: "ゴミ箱から作品を復元", response: dto.MovieDetailResponse{}},
+	{method: http.MethodGet, path: "/api/v1/movies/{id}/history", id: "getMovieHistory", tag: "history", summary: "変更履歴取得", response: dto.AuditEntriesResponse{}},
+	{method: http.MethodGet, path: "/api/v1/movies/{id}/revisions/diff", id: "diffRevisions", tag: "history", summary: "リビジョン間の差分取得", query: struct {
+		From int `query:"from" validate:"required"`
+		To   int `query:"to" validate:"required"`
+	}{}, response: dto.RevisionDiffResponse{}},
+	{method: http.MethodPost, path: "/api/v1/movies/{id}/revisions/{rev}/revert", id: "revertToRevision", tag: "history", summary: "指定リビジョンの状態に戻す", response: dto.MovieDetailResponse{}},
+
+	// 視聴履歴
+	{method: http.MethodGet, path: "/api/v1/movies/{id}/watches", id: "listWatchEvents", tag: "watches", summary: "視聴履歴取得", response: dto.WatchEventsResponse{}},
+	{method: http.MethodPost, path: "/api/v1/movies/{id}/watches", id: "createWatchEvent", tag: "watches", summary: "視聴記録追加", description: "watched_at を省略した場合は現在日時で記録します。", body: dto.CreateWatchEventRequest{}, statuses: []int{http.StatusCreated}, response: dto.WatchEventDetailResponse{}},
+	{method: http.MethodDelete, path: "/api/v1/movies/{id}/watches/{watch_id}", id: "deleteWatchEvent", tag: "watches", summary: "視聴記録削除", response: dto.MessageResponse{}},
+
+	// シーズン・エピソード
+	{method: http.MethodGet, path: "/api/v1/movies/{id}/seasons", id: "listSeasons", tag: "seasons", summary: "シーズン一覧取得", response: dto.SeasonsResponse{}},
+	{method: http.MethodPost, path: "/api/v1/movies/{id}/seasons", id: "createSeason", tag: "seasons", summary: "シーズン作成", body: dto.CreateSeasonRequest{}, statuses: []int{http.StatusCreated}, response: dto.SeasonDetailResponse{}, errors: []int{http.StatusConflict}},
+	{method: http.MethodDelete, path: "/api/v1/movies/{id}/seasons/{season_id}", id: "deleteSeason", tag: "seasons", summary: "シーズン削除", response: dto.MessageResponse{}},
+	{method: http.MethodPost, path: "/api/v1/movies/{id}/episodes/watch", id: "watchEpisodesUpTo", tag: "seasons", summary: "指定話数までまとめて視聴済みにする", body: dto.WatchEpisodesRequest{}, response: dto.MovieDetailResponse{}},
+	{method: http.MethodPost, path: "/api/v1/movies/{id}/episodes/{episode_id}/watch", id: "watchEpisode", tag: "seasons", summary: "エピソードを視聴済みにする", response: dto.MovieDetailResponse{}},
+	{method: http.MethodDelete, path: "/api/v1/movies/{id}/episodes/{episode_id}/watch", id: "unwatchEpisode", tag: "seasons", summary: "エピソードを未視聴に戻す", response: dto.MovieDetailResponse{}},
+
+	// クレジット
+	{method: http.MethodGet, path: "/api/v1/movies/{id}/credits", id: "listCredits", tag: "credits", summary: "作品のクレジット一覧取得", response: dto.CreditsResponse{}},
+	{method: http.MethodPost, path: "/api/v1/movies/{id}/credits", id: "addCredit", tag: "credits", summary: "作品にクレジットを追加", description: "person_id または person_name のいずれかを指定します。person_name は未登録の場合に作成されます。", body: dto.CreditRequest{}, statuses: []int{http.StatusCreated}, response: dto.CreditDetailResponse{}, errors: []int{http.StatusConflict}},
+	{method: http.MethodDelete, path: "/api/v1/movies/{id}/credits/{credit_id}", id: "deleteCredit", tag: "credits", summary: "作品からクレジットを削除", response: dto.MessageResponse{}},
+
+	// 配信状況
+	{method: http.MethodGet, path: "/api/v1/movies/{id}/availability", id: "listAvailabilities", tag: "platforms", summary: "作品の配信状況取得", response: dto.AvailabilitiesResponse{}},
+	{method: http.MethodPut, path: "/api/v1/movies/{id}/availability/{platform}", id: "setAvailability", tag: "platforms", summary: "作品の配信期間を登録・更新", body: dto.AvailabilityRequest{}, response: dto.AvailabilityDetailResponse{}},
+	{method: http.MethodDelete, path: "/api/v1/movies/{id}/availability/{platform}", id: "deleteAvailability", tag: "platforms", summary: "作品の配信状況を削除", response: dto.MessageResponse{}},
+
+	// タグ
+	{method: http.MethodGet, path: "/api/v1/tags", id: "listTags", tag: "tags", summary: "タグ一覧取得", response: dto.TagsResponse{}},
+	{method: http.MethodPost, path: "/api/v1/tags", id: "createTag", tag: "tags", summary: "タグ作成", body: dto.TagRequest{}, statuses: []int{http.StatusCreated}, response: dto.TagDetailResponse{}, errors: []int{http.StatusConflict}},
+	{method: http.MethodGet, path: "/api/v1/tags/{id}", id: "getTag", tag: "tags", summary: "タグ詳細取得", response: dto.TagDetailResponse{}},
+	{method: http.MethodPut, path: "/api/v1/tags/{id}", id: "updateTag", tag: "tags", summary: "タグ名変更", body: dto.TagRequest{}, response: dto.TagDetailResponse{}, errors: []int{http.StatusConflict}},
+	{method: http.MethodDelete, path: "/api/v1/tags/{id}", id: "deleteTag", tag: "tags", summary: "タグ削除", response: dto.MessageResponse{}},
+
+	// リスト
+	{method: http.MethodGet, path: "/api/v1/lists", id: "listLists", tag: "lists", summary: "リスト一覧取得", response: dto.ListsResponse{}},
+	{method: http.MethodPost, path: "/api/v1/lists", id: "createList", tag: "lists", summary: "リスト作成", body: dto.ListRequest{}, statuses: []int{http.StatusCreated}, response: dto.ListDetailResponse{}},
+	{method: http.MethodGet, path: "/api/v1/lists/{id}", id: "getList", tag: "lists", summary: "リスト詳細取得（作品を含む）", response: dto.ListDetailResponse{}},
+	{method: http.MethodPut, path: "/api/v1/lists/{id}", id: "updateList", tag: "lists", summary: "リスト更新", body: dto.ListRequest{}, response: dto.ListDetailResponse{}},
+	{method: http.MethodDelete, path: "/api/v1/lists/{id}", id: "deleteList", tag: "lists", summary: "リスト削除", response: dto.MessageResponse{}},
+	{method: http.MethodPost, path: "/api/v1/lists/{id}/movies", id: "addListMovie", tag: "lists", summary: "リストに作品を追加", description: "position を省略した場合は末尾に追加します。", body: dto.AddListMovieRequest{}, response: dto.ListDetailResponse{}, errors: []int{http.StatusConflict}},
+	{method: http.MethodPut, path: "/api/v1/lists/{id}/movies/order", id: "reorderListMovies", tag: "lists", summary: "リスト内の並び替え", description: "リスト内の全ての作品の ID を新しい順序で指定します。", body: dto.ReorderListRequest{}, response: dto.ListDetailResponse{}},
+	{method: http.MethodDelete, path: "/api/v1/lists/{id}/movies/{movie_id}", id: "removeListMovie", tag: "lists", summary: "リストから作品を外す", response: dto.ListDetailResponse{}},
+
+	// 人物
+	{method: http.MethodGet, path: "/api/v1/people", id: "listPeople", tag: "people", summary: "人物検索", query: dto.PersonFilter{}, params: map[string]string{"q": "名前の部分一致"}, response: dto.PeopleResponse{}},
+	{method: http.MethodPost, path: "/api/v1/people", id: "createPerson", tag: "people", summary: "人物作成", body: dto.PersonRequest{}, statuses: []int{http.StatusCreated}, response: dto.PersonDetailResponse{}, errors: []int{http.StatusConflict}},
+	{method: http.MethodGet, path: "/api/v1/people/{id}", id: "getPerson", tag: "people", summary: "人物詳細取得", response: dto.PersonDetailResponse{}},
+	{method: http.MethodPut, path: "/api/v1/people/{id}", id: "updatePerson", tag: "people", summary: "人物名変更", body: dto.PersonRequest{}, response: dto.PersonDetailResponse{}, errors: []int{http.StatusConflict}},
+	{method: http.MethodDelete, path: "/api/v1/people/{id}", id: "deletePerson", tag: "people", summary: "人物削除", response: dto.MessageResponse{}},
+	{method: http.MethodGet, path: "/api/v1/people/{id}/movies", id: "getFilmography", tag: "people", summary: "人物の担当作品一覧取得", response: dto.FilmographyResponse{}},
+
+	// 配信サービス
+	{method: http.MethodGet, path: "/api/v1/platforms", id: "listPlatforms", tag: "platforms", summary: "配信サービス一覧取得", response: dto.PlatformsResponse{}},
+	{method: http.MethodPost, path: "/api/v1/platforms", id: "createPlatform", tag: "platforms", summary: "配信サービス追加", body: dto.PlatformRequest{}, statuses: []int{http.StatusCreated}, response: dto.PlatformDetailResponse{}, errors: []int{http.StatusConflict}},
+	{method: http.MethodGet, path: "/api/v1/platforms/{slug}/leaving", id: "getLeaving", tag: "platforms", summary: "配信終了予定の作品一覧取得", query: dto.LeavingFilter{}, params: map[string]string{"days": "何日以内に配信終了する作品か（既定 7）", "status": "視聴ステータス"}, response: dto.LeavingMoviesResponse{}},
+
+	// インポート・エクスポート
+	{method: http.MethodPost, path: "/api/v1/import", id: "importMovies", tag: "import", summary: "CSV インポート", description: "既定はプレビューのみで、dry_run=false の場合に取り込みます。1件以上取り込んだ場合は 201 を返します。", form: dto.ImportRequest{}, files: []string{"file"}, statuses: []int{http.StatusOK, http.StatusCreated}, response: dto.ImportResponse{}},
+	{method: http.MethodGet, path: "/api/v1/export", id: "exportMovies", tag: "export", summary: "作品のエクスポート", description: "一覧と同じ絞り込み・並び順の全ての作品を出力します（limit・cursor は無視）。csv は source=watchlist、letterboxd は source=letterboxd でそのまま取り込めます。", query: dto.ExportRequest{}, params: movieFilterParams, content: map[string]any{
+		"text/csv":             nil,
+		mimeJSON:               []*dto.MovieResponse{},
+		"application/x-ndjson": nil,
+	}},
+
+	// Webhook
+	{method: http.MethodGet, path: "/api/v1/webhooks", id: "listWebhooks", tag: "webhooks", summary: "Webhook 一覧取得", response: dto.WebhooksResponse{}},
+	{method: http.MethodPost, path: "/api/v1/webhooks", id: "createWebhook", tag: "webhooks", summary: "Webhook 作成", description: "secret を省略した場合は生成します。secret はこのレスポンスでのみ返します。", body: dto.WebhookRequest{}, statuses: []int{http.StatusCreated}, response: dto.WebhookDetailResponse{}},
+	{method: http.MethodGet, path: "/api/v1/webhooks/{id}", id: "getWebhook", tag: "webhooks", summary: "Webhook 詳細取得", response: dto.WebhookDetailResponse{}},
+	{method: http.MethodPut, path: "/api/v1/webhooks/{id}", id: "updateWebhook", tag: "webhooks", summary: "Webhook 更新", description: "secret を省略した場合は変更しません。", body: dto.WebhookRequest{}, response: dto.WebhookDetailResponse{}},
+	{method: http.MethodDelete, path: "/api/v1/webhooks/{id}", id: "deleteWebhook", tag: "webhooks", summary: "Webhook 削除（配信履歴も削除）", response: dto.MessageResponse{}},
+	{method: http.MethodGet, path: "/api/v1/webhooks/{id}/deliveries", id: "listWebhookDeliveries", tag: "webhooks", summary: "配信履歴取得", query: dto.WebhookDeliveryFilter{}, params: map[string]string{"limit": "取得件数（既定 20）"}, response: dto.WebhookDeliveriesResponse{}},
+	{method: http.MethodGet, path: "/api/v1/webhooks/{id}/deliveries/{delivery_id}", id: "getWebhookDelivery", tag: "webhooks", summary: "配信履歴詳細取得（送信した本文を含む）", response: dto.WebhookDeliveryDetailResponse{}},
+	{method: http.MethodPost, path: "/api/v1/webhooks/{id}/deliveries/{delivery_id}/redeliver", id: "redeliverWebhook", tag: "webhooks", summary: "配信を同じ本文で再送", description: "新しい配信として登録し、非同期に送信します。", statuses: []int{http.StatusAccepted}, response: dto.WebhookDeliveryDetailResponse{}},
+
+	// ゴミ箱
+	{method: http.MethodGet, path: "/api/v1/trash", id: "listTrash", tag: "trash", summary: "ゴミ箱の作品一覧取得", response: dto.MoviesResponse{}},
+	{method: http.MethodDelete, path: "/api/v1/trash/{id}", id: "purgeMovie", tag: "trash", summary: "ゴミ箱の作品を完全に削除", response: dto.MessageResponse{}},
+
+	// 統計
+	{method: http.MethodGet, path: "/api/v1/stats/genres", id: "getGenres", tag: "stats", summary: "ジャンル一覧取得", response: genresResponse{}},
+	{method: http.MethodGet, path: "/api/v1/stats/watch", id: "getWatchStats", tag: "stats", summary: "視聴統計取得", response: watchStatsResponse{}},
+	{method: http.MethodGet, path: "/api/v1/stats/people", id: "getPersonStats", tag: "stats", summary: "人物別の視聴統計取得", query: dto.PersonStatsFilter{}, response: personStatsResponse{}},
+
+	// GraphQL
+	{method: http.MethodPost, path: "/graphql", id: "graphql", tag: "graphql", summary: "GraphQL クエリの実行", description: "クエリのエラーは 200 で errors に含めて返します。", body: dto.GraphQLRequest{}, response: graphQLResponse{}},
+}
+
+var pathParamPattern = regexp.MustCompile(`\{(\w+)\}`)
+
+// 整数以外のパスパラメータ
+var stringPathParams = map[string]bool{
+	"slug":     true,
+	"platform": true,
+}
+
+// APIの OpenAPI ドキュメントを生成する
+func Build() *Document {
+	g := newSchemaGenerator()
+	doc := &Document{
+		OpenAPI: "3.0.3",
+		Info: Info{
+			Title:       "Watchlist App API",
+			Description: "映画やドラマなどの視聴作品を管理する API です。",
+			Version:     "1.0.0",
+		},
+		Paths: map[string]*PathItem{},
+	}
+
+	seenTags := map[string]bool{}
+	for _, r := range routes {
+		item, ok := doc.Paths[r.path]
+		if !ok {
+			item = &PathItem{}
+			doc.Paths[r.path] = item
+		}
+		(*item)[lowerMethod(r.method)] = g.operation(r)
+
+		if !seenTags[r.tag] {
+			seenTags[r.tag] = true
+			doc.Tags = append(doc.Tags, Tag{Name: r.tag})
+		}
+	}
+
+	g.schemas["Error"] = &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"code":    {Type: "integer", Format: "int32"},
+			"message": {Type: "string"},
+		},
+		Required: []string{"code", "message"},
+	}
+	doc.Components = Components{
+		Schemas: g.schemas,
+		SecuritySchemes: map[string]*SecurityScheme{
+			securitySchemeName: {Type: "http", Scheme: "bearer", BearerFormat: "JWT"},
+		},
+	}
+	return doc
+}
+
+func (g *schemaGenerator) operation(r route) *Operation {
+	op := &Operation{
+		Tags:        []string{r.tag},
+		Summary:     r.summary,
+		Description: r.description,
+		OperationID: r.id,
+		Responses:   map[string]*Response{},
+	}
+	if !r.public {
+		op.Security = []SecurityRequirement{{securitySchemeName: []string{}}}
+	}
+
+	pathParams := pathParamPattern.FindAllStringSubmatch(r.path, -1)
+	for _, m := range pathParams {
+		schema := &Schema{Type: "integer", Format: "int32"}
+		if stringPathParams[m[1]] {
+			schema = &Schema{Type: "string"}
+		}
+		op.Parameters = append(op.Parameters, &Parameter{Name: m[1], In: "path", Required: true, Schema: schema})
+	}
+
+	if r.ifMatch {
+		op.Parameters = append(op.Parameters, &Parameter{
+			Name:        "If-Match",
+			In:          "header",
+			Description: "取得時の ETag。一致しない場合は 412、省略時は 428 を返す（* はバージョンを確認しない）",
+			Required:    true,
+			Schema:      &Schema{Type: "string"},
+		})
+	}
+
+	if r.query != nil {
+		g.eachField(reflect.TypeOf(r.query), "query", func(name string, _ reflect.StructField, s *Schema, required bool) {
+			op.Parameters = append(op.Parameters, &Parameter{
+				Name:        name,
+				In:          "query",
+				Description: r.params[name],
+				Required:    required,
+				Schema:      s,
+			})
+		})
+	}
+
+	if r.body != nil {
+		bodyType := r.bodyType
+		if bodyType == "" {
+			bodyType = mimeJSON
+		}
+		op.RequestBody = &RequestBody{
+			Required: true,
+			Content:  map[string]*MediaType{bodyType: {Schema: g.schemaFor(reflect.TypeOf(r.body))}},
+		}
+	}
+
+	if r.form != nil {
+		schema := g.objectSchema(reflect.TypeOf(r.form), "form")
+		for _, name := range r.files {
+			schema.Properties[name] = &Schema{Type: "string", Format: "binary"}
+			schema.Required = append(schema.Required, name)
+		}
+		op.RequestBody = &RequestBody{
+			Required: true,
+			Content:  map[string]*MediaType{mimeMultipart: {Schema: schema}},
+		}
+	}
+
+	statuses := r.statuses
+	if len(statuses) == 0 {
+		statuses = []int{http.StatusOK}
+	}
+	for _, status := range statuses {
+		op.Responses[strconv.Itoa(status)] = g.response(r, status)
+	}
+
+	// 入力・認証・対象の有無に応じたエラー
+	errorStatuses := append([]int{}, r.errors...)
+	if r.query != nil || r.body != nil || r.form != nil || len(pathParams) > 0 || r.ifMatch {
+		errorStatuses = append(errorStatuses, http.StatusBadRequest)
+	}
+	if !r.public {
+		errorStatuses = append(errorStatuses, http.StatusUnauthorized)
+	}
+	if len(pathParams) > 0 {
+		errorStatuses = append(errorStatuses, http.StatusNotFound)
+	}
+	if r.ifMatch {
+		errorStatuses = append(errorStatuses, http.StatusPreconditionFailed, http.StatusPreconditionRequired)
+	}
+	for _, status := range errorStatuses {
+		op.Responses[strconv.Itoa(status)] = &Response{
+			Description: http.StatusText(status),
+			Content:     map[string]*MediaType{mimeJSON: {Schema: &Schema{Ref: "#/components/schemas/Error"}}},
+		}
+	}
+	return op
+}
+
+func (g *schemaGenerator) response(r route, status int) *Response {
+	res := &Response{Description: http.StatusText(status)}
+	if r.response != nil || len(r.content) > 0 {
+		res.Content = map[string]*MediaType{}
+	}
+	if r.response != nil {
+		res.Content[mimeJSON] = &MediaType{Schema: g.schemaFor(reflect.TypeOf(r.response))}
+	}
+	for contentType, v := range r.content {
+		schema := &Schema{Type: "string"}
+		if v != nil {
+			schema = g.schemaFor(reflect.TypeOf(v))
+		}
+		res.Content[contentType] = &MediaType{Schema: schema}
+	}
+	if r.etag {
+		res.Headers = map[string]*Header{
+			"ETag": {Description: "作品のバージョン（更新・削除時に If-Match に指定する）", Schema: &Schema{Type: "string"}},
+		}
+	}
+	return res
+}
+
+func lowerMethod(method string) string {
+	return strings.ToLower(method)
+}
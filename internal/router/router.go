@@ -2,33 +2,170 @@ package router
 
 import (
 	"watchlist-app/ent"
+	"watchlist-app/internal/graph"
 	"watchlist-app/internal/handler"
+	"watchlist-app/internal/middleware"
+	"watchlist-app/internal/openapi"
 	"watchlist-app/internal/service"
+	"watchlist-app/pkg/auth"
+	"watchlist-app/pkg/config"
 
 	"github.com/labstack/echo/v4"
 )
 
-func SetupRoutes(e *echo.Echo, client *ent.Client) {
+func SetupRoutes(e *echo.Echo, client *ent.Client, cfg *config.Config) {
 	// サービス初期化
+	tokenManager := auth.NewTokenManager(cfg.Auth.JWTSecret, cfg.Auth.AccessTokenTTL)
 	movieService := service.NewMovieService(client)
+	episodeService := service.NewEpisodeService(client)
+	tagService := service.NewTagService(client)
+	listService := service.NewListService(client)
+	personService := service.NewPersonService(client)
+	platformService := service.NewPlatformService(client)
+	trashService := service.NewTrashService(client, cfg.Trash.Retention)
+	auditService := service.NewAuditService(client)
+	watchEventService := service.NewWatchEventService(client)
+	importService := service.NewImportService(client)
+	userService := service.NewUserService(client)
+	webhookService := service.NewWebhookService(client, cfg.Webhook.Timeout, cfg.Webhook.MaxAttempts)
+	authService := service.NewAuthService(client, userService, tokenManager, cfg.Auth.RefreshTokenTTL)
 
 	// ハンドル初期化
 	movieHandle := handler.NewMovieHandler(movieService)
+	episodeHandle := handler.NewEpisodeHandler(episodeService)
+	tagHandle := handler.NewTagHandler(tagService)
+	listHandle := handler.NewListHandler(listService)
+	personHandle := handler.NewPersonHandler(personService)
+	platformHandle := handler.NewPlatformHandler(platformService)
+	trashHandle := handler.NewTrashHandler(trashService, movieService)
+	auditHandle := handler.NewAuditHandler(auditService, movieService)
+	watchEventHandle := handler.NewWatchEventHandler(watchEventService)
+	importHandle := handler.NewImportHandler(importService)
+	exportHandle := handler.NewExportHandler(movieService)
+	graphqlHandle := handler.NewGraphQLHandler(graph.NewSchema(movieService, watchEventService, episodeService))
+	authHandle := handler.NewAuthHandler(userService, authService)
+	webhookHandle := handler.NewWebhookHandler(webhookService)
+	docsHandle := handler.NewDocsHandler(openapi.Build())
+
+	// 認証ミドルウェア
+	requireAuth := middleware.JWTAuth(authService)
+
+	// GraphQL（作品の取得・作成・更新・削除）
+	e.POST("/graphql", graphqlHandle.Query, requireAuth)
+
+	// APIドキュメント（認証不要）
+	e.GET("/docs", docsHandle.UI)
 
 	// API v1グループ
 	api := e.Group("/api/v1")
+	api.GET("/openapi.json", docsHandle.Spec)
+
+	// 認証関連ルート（登録・ログイン・トークン再発行は認証不要）
+	authRoutes := api.Group("/auth")
+	authRoutes.POST("/register", authHandle.Register)
+	authRoutes.POST("/login", authHandle.Login)
+	authRoutes.POST("/refresh", authHandle.Refresh)
+	authRoutes.POST("/logout", authHandle.Logout)
+	authRoutes.POST("/logout/all", authHandle.LogoutAll, requireAuth)
+	authRoutes.GET("/me", authHandle.Me, requireAuth)
 
 	// 映画関連ルート
-	movies := api.Group("/movies")
+	movies := api.Group("/movies", requireAuth)
 	movies.GET("", movieHandle.GetMovies)
 	movies.POST("", movieHandle.CreateMovie)
+	movies.POST("/bulk", movieHandle.BulkMovies)
 	movies.GET("/:id", movieHandle.GetMovie)
 	movies.PUT("/:id", movieHandle.UpdateMovie)
+	movies.PATCH("/:id", movieHandle.PatchMovie)
 	movies.DELETE("/:id", movieHandle.DeleteMovie)
+	movies.POST("/:id/restore", trashHandle.Restore)
+	movies.GET("/:id/history", auditHandle.GetMovieHistory)
+	movies.GET("/:id/revisions/diff", auditHandle.DiffRevisions)
+	movies.POST("/:id/revisions/:rev/revert", auditHandle.RevertToRevision)
+
+	// 視聴履歴関連ルート
+	movies.GET("/:id/watches", watchEventHandle.GetWatchEvents)
+	movies.POST("/:id/watches", watchEventHandle.CreateWatchEvent)
+	movies.DELETE("/:id/watches/:watch_id", watchEventHandle.DeleteWatchEvent)
+
+	// シーズン・エピソード関連ルート
+	movies.GET("/:id/seasons", episodeHandle.GetSeasons)
+	movies.POST("/:id/seasons", episodeHandle.CreateSeason)
+	movies.DELETE("/:id/seasons/:season_id", episodeHandle.DeleteSeason)
+	movies.POST("/:id/episodes/watch", episodeHandle.WatchEpisodesUpTo)
+	movies.POST("/:id/episodes/:episode_id/watch", episodeHandle.WatchEpisode)
+	movies.DELETE("/:id/episodes/:episode_id/watch", episodeHandle.UnwatchEpisode)
+
+	// クレジット関連ルート
+	movies.GET("/:id/credits", personHandle.GetCredits)
+	movies.POST("/:id/credits", personHandle.AddCredit)
+	movies.DELETE("/:id/credits/:credit_id", personHandle.DeleteCredit)
+
+	// 配信状況関連ルート
+	movies.GET("/:id/availability", platformHandle.GetAvailabilities)
+	movies.PUT("/:id/availability/:platform", platformHandle.SetAvailability)
+	movies.DELETE("/:id/availability/:platform", platformHandle.DeleteAvailability)
+
+	// タグ関連ルート
+	tags := api.Group("/tags", requireAuth)
+	tags.GET("", tagHandle.GetTags)
+	tags.POST("", tagHandle.CreateTag)
+	tags.GET("/:id", tagHandle.GetTag)
+	tags.PUT("/:id", tagHandle.UpdateTag)
+	tags.DELETE("/:id", tagHandle.DeleteTag)
+
+	// リスト関連ルート
+	lists := api.Group("/lists", requireAuth)
+	lists.GET("", listHandle.GetLists)
+	lists.POST("", listHandle.CreateList)
+	lists.GET("/:id", listHandle.GetList)
+	lists.PUT("/:id", listHandle.UpdateList)
+	lists.DELETE("/:id", listHandle.DeleteList)
+	lists.POST("/:id/movies", listHandle.AddMovie)
+	lists.PUT("/:id/movies/order", listHandle.ReorderMovies)
+	lists.DELETE("/:id/movies/:movie_id", listHandle.RemoveMovie)
+
+	// 人物関連ルート
+	people := api.Group("/people", requireAuth)
+	people.GET("", personHandle.GetPeople)
+	people.POST("", personHandle.CreatePerson)
+	people.GET("/:id", personHandle.GetPerson)
+	people.PUT("/:id", personHandle.UpdatePerson)
+	people.DELETE("/:id", personHandle.DeletePerson)
+	people.GET("/:id/movies", personHandle.GetFilmography)
+
+	// 配信サービス関連ルート
+	platforms := api.Group("/platforms", requireAuth)
+	platforms.GET("", platformHandle.GetPlatforms)
+	platforms.POST("", platformHandle.CreatePlatform)
+	platforms.GET("/:slug/leaving", platformHandle.GetLeaving)
+
+	// インポート関連ルート
+	api.POST("/import", importHandle.Import, requireAuth)
+
+	// エクスポート関連ルート
+	api.GET("/export", exportHandle.Export, requireAuth)
+
+	// Webhook関連ルート
+	webhooks := api.Group("/webhooks", requireAuth)
+	webhooks.GET("", webhookHandle.GetWebhooks)
+	webhooks.POST("", webhookHandle.CreateWebhook)
+	webhooks.GET("/:id", webhookHandle.GetWebhook)
+	webhooks.PUT("/:id", webhookHandle.UpdateWebhook)
+	webhooks.DELETE("/:id", webhookHandle.DeleteWebhook)
+	webhooks.GET("/:id/deliveries", webhookHandle.GetDeliveries)
+	webhooks.GET("/:id/deliveries/:delivery_id", webhookHandle.GetDelivery)
+	webhooks.POST("/:id/deliveries/:delivery_id/redeliver", webhookHandle.Redeliver)
+
+	// ゴミ箱関連ルート
+	trash := api.Group("/trash", requireAuth)
+	trash.GET("", trashHandle.GetTrash)
+	trash.DELETE("/:id", trashHandle.Purge)
 
 	// 統計用エンドポイント
-	stats := api.Group("/stats")
+	stats := api.Group("/stats", requireAuth)
 	stats.GET("/genres", movieHandle.GetGenres)
 
 	stats.GET("/watch", movieHandle.GetWatchStats)
-}
\ No newline at end of file
+	stats.GET("/people", personHandle.GetPersonStats)
+}
@@ -0,0 +1,80 @@
+package router
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+	"testing"
+	"watchlist-app/ent/enttest"
+	"watchlist-app/internal/openapi"
+	"watchlist-app/pkg/config"
+
+	"github.com/labstack/echo/v4"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+var echoPathParam = regexp.MustCompile(`:(\w+)`)
+
+// SetupRoutes 以外（cmd/server/main.go）で登録されるルート
+var routesOutsideRouter = map[string]bool{
+	"GET /health": true,
+}
+
+func setupTestRoutes(t *testing.T) *echo.Echo {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	e := echo.New()
+	SetupRoutes(e, client, &config.Config{})
+	return e
+}
+
+// 登録された全てのルートが OpenAPI ドキュメントに定義されていること
+func TestOpenAPICoversAllRoutes(t *testing.T) {
+	e := setupTestRoutes(t)
+	spec := openapi.Build()
+
+	registered := map[string]bool{}
+	for _, r := range e.Routes() {
+		// グループのミドルウェア用に自動登録されるルートは除く
+		if r.Method == echo.RouteNotFound {
+			continue
+		}
+		path := echoPathParam.ReplaceAllString(r.Path, "{$1}")
+		registered[r.Method+" "+path] = true
+
+		if !spec.HasOperation(r.Method, path) {
+			t.Errorf("%s %s が OpenAPI ドキュメントに定義されていません", r.Method, path)
+		}
+	}
+
+	// 削除されたルートがドキュメントに残っていないこと
+	for path, item := range spec.Paths {
+		for method := range *item {
+			key := strings.ToUpper(method) + " " + path
+			if !registered[key] && !routesOutsideRouter[key] {
+				t.Errorf("%s は OpenAPI ドキュメントにありますが、ルートが登録されていません", key)
+			}
+		}
+	}
+}
+
+// ドキュメント内の $ref が全て components に定義されていること
+func TestOpenAPIReferencesResolve(t *testing.T) {
+	spec := openapi.Build()
+
+	b, err := json.Marshal(spec)
+	if err != nil {
+		t.Fatalf("OpenAPI ドキュメントを JSON に変換できません: %v", err)
+	}
+
+	refs := regexp.MustCompile(`"\$ref":"#/components/schemas/([^"]+)"`).FindAllStringSubmatch(string(b), -1)
+	if len(refs) == 0 {
+		t.Fatal("$ref が1件もありません")
+	}
+	for _, m := range refs {
+		if _, ok := spec.Components.Schemas[m[1]]; !ok {
+			t.Errorf("スキーマ %s が components に定義されていません", m[1])
+		}
+	}
+}
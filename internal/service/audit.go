@@ -0,0 +1,253 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+
+	"watchlist-app/ent"
+	"watchlist-app/ent/auditentry"
+	"watchlist-app/ent/movie"
+	"watchlist-app/ent/schema"
+	"watchlist-app/pkg/audit"
+	"watchlist-app/pkg/errors"
+)
+
+// リビジョンへの巻き戻し対象のフィールド
+// watched_at は視聴履歴から同期する値、user_id・deleted_at は所有者・ゴミ箱の状態のため対象外
+var revertibleFields = map[string]bool{
+	movie.FieldTitle:       true,
+	movie.FieldDescription: true,
+	movie.FieldGenre:       true,
+	movie.FieldReleaseYear: true,
+	movie.FieldPosterURL:   true,
+	movie.FieldMediaType:   true,
+	movie.FieldWatchStatus: true,
+	movie.FieldRating:      true,
+	movie.FieldReview:      true,
+}
+
+type AuditService struct {
+	client *ent.Client
+}
+
+func NewAuditService(client *ent.Client) *AuditService {
+	return &AuditService{
+		client: client,
+	}
+}
+
+// 作品の変更履歴取得（新しい順、ゴミ箱の作品も対象）
+// 返す履歴の i 番目はリビジョン len(entries)-i に対応する
+func (s *AuditService) GetMovieHistory(ctx context.Context, userID, movieID int) ([]*ent.AuditEntry, error) {
+	exists, err := s.client.Movie.Query().
+		Where(movie.IDEQ(movieID), movie.UserIDEQ(userID)).
+		Exist(schema.SkipSoftDelete(ctx))
+	if err != nil {
+		return nil, errors.NewInternalServerError("映画の取得に失敗しました")
+	}
+	if !exists {
+		return nil, errors.NewNotFoundError("映画が見つかりません")
+	}
+
+	entries, err := s.client.AuditEntry.Query().
+		Where(auditentry.MovieIDEQ(movieID)).
+		Order(ent.Desc(auditentry.FieldCreatedAt), ent.Desc(auditentry.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.NewInternalServerError("変更履歴の取得に失敗しました")
+	}
+	return entries, nil
+}
+
+// 2つのリビジョン間で値の異なるフィールドを取得（Old が from、New が to 時点の値）
+func (s *AuditService) DiffRevisions(ctx context.Context, userID, movieID, from, to int) (audit.Changes, error) {
+	history, err := s.GetMovieHistory(ctx, userID, movieID)
+	if err != nil {
+		return nil, err
+	}
+	entries := chronological(history)
+	if !validRevision(entries, from) || !validRevision(entries, to) {
+		return nil, errors.NewNotFoundError("リビジョンが見つかりません")
+	}
+
+	before := stateAt(entries, from, nil)
+	after := stateAt(entries, to, nil)
+	changes := audit.Changes{}
+	for name, value := range after {
+		changes[name] = audit.FieldChange{Old: before[name], New: value}
+	}
+	for name, value := range before {
+		if _, ok := after[name]; !ok {
+			changes[name] = audit.FieldChange{Old: value}
+		}
+	}
+	for name, c := range changes {
+		if sameValue(c.Old, c.New) {
+			delete(changes, name)
+		}
+	}
+	return changes, nil
+}
+
+// 作品の各フィールドを指定したリビジョン時点の値に戻す
+// 巻き戻し自体も変更履歴に revert として記録される
+func (s *AuditService) RevertToRevision(ctx context.Context, userID, movieID, rev int) error {
+	return withTx(ctx, s.client, func(tx *ent.Tx) error {
+		exists, err := tx.Movie.Query().
+			Where(movie.IDEQ(movieID), movie.UserIDEQ(userID)).
+			Exist(ctx)
+		if err != nil {
+			return errors.NewInternalServerError("映画の取得に失敗しました")
+		}
+		if !exists {
+			return errors.NewNotFoundError("映画が見つかりません")
+		}
+
+		entries, err := tx.AuditEntry.Query().
+			Where(auditentry.MovieIDEQ(movieID)).
+			Order(ent.Asc(auditentry.FieldCreatedAt), ent.Asc(auditentry.FieldID)).
+			All(ctx)
+		if err != nil {
+			return errors.NewInternalServerError("変更履歴の取得に失敗しました")
+		}
+		if !validRevision(entries, rev) {
+			return errors.NewNotFoundError("リビジョンが見つかりません")
+		}
+
+		state := stateAt(entries, rev, revertibleFields)
+		builder, err := applyRevision(tx.Movie.UpdateOneID(movieID), state)
+		if err != nil {
+			return errors.NewInternalServerError("リビジョンの復元に失敗しました")
+		}
+		// 作成・更新と同じく、戻したジャンルと同名のタグを付ける（ジャンルを外してもタグは残す）
+		if genre, ok := state[movie.FieldGenre].(string); ok && genre != "" {
+			tagIDs, err := genreTagIDs(ctx, tx.Client(), userID, movieID, genre)
+			if err != nil {
+				return err
+			}
+			builder = builder.AddTagIDs(tagIDs...)
+		}
+		if err := builder.Exec(schema.WithAuditAction(ctx, auditentry.ActionRevert)); err != nil {
+			if ent.IsValidationError(err) {
+				return errors.NewBadRequestError("このリビジョンの値は現在の制約を満たしていません")
+			}
+			return errors.NewInternalServerError("リビジョンの復元に失敗しました")
+		}
+		return nil
+	})
+}
+
+// 新しい順の履歴を古い順に並べ替える（i 番目がリビジョン i+1）
+func chronological(history []*ent.AuditEntry) []*ent.AuditEntry {
+	entries := make([]*ent.AuditEntry, len(history))
+	for i, e := range history {
+		entries[len(history)-1-i] = e
+	}
+	return entries
+}
+
+func validRevision(entries []*ent.AuditEntry, rev int) bool {
+	return rev >= 1 && rev <= len(entries)
+}
+
+// リビジョン rev 時点の各フィールドの値を履歴から求める
+// rev までに変更されたフィールドは最後の変更後の値、rev 以降に初めて変更されたフィールドはその変更前の値を使う。
+// 一度も変更されていないフィールドは現在の値のままなので含めない。fields が nil の場合は全フィールドを対象とする
+func stateAt(entries []*ent.AuditEntry, rev int, fields map[string]bool) map[string]any {
+	state := map[string]any{}
+	for i, e := range entries {
+		for name, c := range e.Changes {
+			if fields != nil && !fields[name] {
+				continue
+			}
+			if i < rev {
+				state[name] = c.New
+			} else if _, ok := state[name]; !ok {
+				state[name] = c.Old
+			}
+		}
+	}
+	return state
+}
+
+// 値を JSON 表現で比較する（履歴の値は JSON から復元されるため型が揃わない）
+// 未設定の任意フィールドは読み出すとゼロ値になるため、ゼロ値と null は同じ値とみなす
+func sameValue(a, b any) bool {
+	aj, _ := json.Marshal(a)
+	bj, _ := json.Marshal(b)
+	return normalizeEmpty(string(aj)) == normalizeEmpty(string(bj))
+}
+
+func normalizeEmpty(v string) string {
+	switch v {
+	case `""`, "0", `"0001-01-01T00:00:00Z"`:
+		return "null"
+	}
+	return v
+}
+
+// フィールド名 → 値のマップを更新ビルダーに設定する。値が空のフィールドはクリアする
+func applyRevision(builder *ent.MovieUpdateOne, state map[string]any) (*ent.MovieUpdateOne, error) {
+	b, err := json.Marshal(state)
+	if err != nil {
+		return nil, err
+	}
+	var target ent.Movie
+	if err := json.Unmarshal(b, &target); err != nil {
+		return nil, err
+	}
+
+	for name := range state {
+		switch name {
+		case movie.FieldTitle:
+			if target.Title != "" {
+				builder = builder.SetTitle(target.Title)
+			}
+		case movie.FieldDescription:
+			if target.Description != "" {
+				builder = builder.SetDescription(target.Description)
+			} else {
+				builder = builder.ClearDescription()
+			}
+		case movie.FieldGenre:
+			if target.Genre != "" {
+				builder = builder.SetGenre(target.Genre)
+			} else {
+				builder = builder.ClearGenre()
+			}
+		case movie.FieldReleaseYear:
+			if target.ReleaseYear > 0 {
+				builder = builder.SetReleaseYear(target.ReleaseYear)
+			} else {
+				builder = builder.ClearReleaseYear()
+			}
+		case movie.FieldPosterURL:
+			if target.PosterURL != "" {
+				builder = builder.SetPosterURL(target.PosterURL)
+			} else {
+				builder = builder.ClearPosterURL()
+			}
+		case movie.FieldMediaType:
+			if target.MediaType != "" {
+				builder = builder.SetMediaType(target.MediaType)
+			}
+		case movie.FieldWatchStatus:
+			if target.WatchStatus != "" {
+				builder = builder.SetWatchStatus(target.WatchStatus)
+			}
+		case movie.FieldRating:
+			if target.Rating > 0 {
+				builder = builder.SetRating(target.Rating)
+			} else {
+				builder = builder.ClearRating()
+			}
+		case movie.FieldReview:
+			if target.Review != "" {
+				builder = builder.SetReview(target.Review)
+			} else {
+				builder = builder.ClearReview()
+			}
+		}
+	}
+	return builder, nil
+}
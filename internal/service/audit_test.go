@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"watchlist-app/dto"
+	"watchlist-app/ent"
+	"watchlist-app/ent/enttest"
+	"watchlist-app/ent/tag"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// 巻き戻しでも作成・更新と同じくジャンルのタグを付ける
+func TestRevertToRevisionTagsGenre(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	u := client.User.Create().
+		SetEmail("user@example.com").
+		SetPasswordHash("hash").
+		SetName("user").
+		SaveX(ctx)
+	movies := NewMovieService(client)
+	audits := NewAuditService(client)
+
+	// リビジョン1: ジャンル SF で作成
+	m, err := movies.CreateMovie(ctx, u.ID, &dto.CreateMovieRequest{Title: "Movie", MediaType: "movie", Genre: "SF"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// リビジョン2: ジャンルをドラマにし、SF のタグを外す
+	m, err = movies.UpdateMovie(ctx, u.ID, m.ID, &dto.UpdateMovieRequest{Genre: "ドラマ", Tags: []string{}}, IfMatch{Any: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := audits.RevertToRevision(ctx, u.ID, m.ID, 1); err != nil {
+		t.Fatalf("RevertToRevision error: %v", err)
+	}
+
+	got := client.Movie.GetX(ctx, m.ID)
+	tags := client.Movie.QueryTags(got).Order(ent.Asc(tag.FieldName)).Select(tag.FieldName).StringsX(ctx)
+	if got.Genre != "SF" || !slices.Contains(tags, "SF") {
+		t.Errorf("genre = %q, tags = %v, want SF in both", got.Genre, tags)
+	}
+}
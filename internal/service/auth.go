@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"watchlist-app/ent"
+	"watchlist-app/ent/refreshtoken"
+	"watchlist-app/pkg/auth"
+	"watchlist-app/pkg/errors"
+)
+
+// 発行したトークン一式
+type TokenPair struct {
+	AccessToken     string
+	RefreshToken    string
+	AccessExpiresAt time.Time
+	User            *ent.User
+}
+
+type AuthService struct {
+	client      *ent.Client
+	userService *UserService
+	tokens      *auth.TokenManager
+	refreshTTL  time.Duration
+}
+
+func NewAuthService(client *ent.Client, userService *UserService, tokens *auth.TokenManager, refreshTTL time.Duration) *AuthService {
+	return &AuthService{
+		client:      client,
+		userService: userService,
+		tokens:      tokens,
+		refreshTTL:  refreshTTL,
+	}
+}
+
+// ログインしてトークンを発行
+func (s *AuthService) Login(ctx context.Context, email, password string) (*TokenPair, error) {
+	u, err := s.userService.Authenticate(ctx, email, password)
+	if err != nil {
+		return nil, err
+	}
+	return s.issueTokens(ctx, s.client, u)
+}
+
+// リフレッシュトークンをローテーションして新しいトークンを発行
+func (s *AuthService) Refresh(ctx context.Context, refreshToken string) (*TokenPair, error) {
+	rt, err := s.client.RefreshToken.Query().
+		Where(refreshtoken.TokenHashEQ(auth.HashRefreshToken(refreshToken))).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.NewUnauthorizedError("リフレッシュトークンが無効です")
+		}
+		return nil, errors.NewInternalServerError("トークンの再発行に失敗しました")
+	}
+
+	// 失効済みトークンの再利用は漏洩とみなし、そのユーザーの全セッションを失効させる
+	if rt.RevokedAt != nil {
+		if err := s.LogoutAll(ctx, rt.UserID); err != nil {
+			return nil, err
+		}
+		return nil, errors.NewUnauthorizedError("リフレッシュトークンが無効です")
+	}
+
+	if time.Now().After(rt.ExpiresAt) {
+		return nil, errors.NewUnauthorizedError("リフレッシュトークンの有効期限が切れています")
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, errors.NewInternalServerError("トークンの再発行に失敗しました")
+	}
+
+	// 同時リクエストで二重にローテーションされないよう、未失効の場合のみ失効させる
+	n, err := tx.RefreshToken.Update().
+		Where(refreshtoken.IDEQ(rt.ID), refreshtoken.RevokedAtIsNil()).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, errors.NewInternalServerError("トークンの再発行に失敗しました")
+	}
+	if n == 0 {
+		_ = tx.Rollback()
+		return nil, errors.NewUnauthorizedError("リフレッシュトークンが無効です")
+	}
+
+	pair, err := s.issueTokens(ctx, tx.Client(), rt.Edges.User)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.NewInternalServerError("トークンの再発行に失敗しました")
+	}
+	return pair, nil
+}
+
+// リフレッシュトークンを失効させる（ログアウト）
+func (s *AuthService) Logout(ctx context.Context, refreshToken string) error {
+	_, err := s.client.RefreshToken.Update().
+		Where(
+			refreshtoken.TokenHashEQ(auth.HashRefreshToken(refreshToken)),
+			refreshtoken.RevokedAtIsNil(),
+		).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return errors.NewInternalServerError("ログアウトに失敗しました")
+	}
+	return nil
+}
+
+// ユーザーの全リフレッシュトークンを失効させる（全端末からログアウト）
+func (s *AuthService) LogoutAll(ctx context.Context, userID int) error {
+	_, err := s.client.RefreshToken.Update().
+		Where(
+			refreshtoken.UserIDEQ(userID),
+			refreshtoken.RevokedAtIsNil(),
+		).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return errors.NewInternalServerError("ログアウトに失敗しました")
+	}
+	return nil
+}
+
+// アクセストークンを検証し、ユーザーIDを返す
+func (s *AuthService) VerifyAccessToken(token string) (int, error) {
+	userID, err := s.tokens.ParseAccessToken(token)
+	if err != nil {
+		return 0, errors.NewUnauthorizedError("アクセストークンが無効です")
+	}
+	return userID, nil
+}
+
+func (s *AuthService) issueTokens(ctx context.Context, client *ent.Client, u *ent.User) (*TokenPair, error) {
+	accessToken, expiresAt, err := s.tokens.IssueAccessToken(u.ID)
+	if err != nil {
+		return nil, errors.NewInternalServerError("トークンの発行に失敗しました")
+	}
+
+	refreshToken, hash, err := auth.NewRefreshToken()
+	if err != nil {
+		return nil, errors.NewInternalServerError("トークンの発行に失敗しました")
+	}
+
+	err = client.RefreshToken.Create().
+		SetUserID(u.ID).
+		SetTokenHash(hash).
+		SetExpiresAt(time.Now().Add(s.refreshTTL)).
+		Exec(ctx)
+	if err != nil {
+		return nil, errors.NewInternalServerError("トークンの発行に失敗しました")
+	}
+
+	return &TokenPair{
+		AccessToken:     accessToken,
+		RefreshToken:    refreshToken,
+		AccessExpiresAt: expiresAt,
+		User:            u,
+	}, nil
+}
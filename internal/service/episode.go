@@ -0,0 +1,280 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"watchlist-app/dto"
+	"watchlist-app/ent"
+	"watchlist-app/ent/episode"
+	"watchlist-app/ent/movie"
+	"watchlist-app/ent/season"
+	"watchlist-app/pkg/errors"
+)
+
+type EpisodeService struct {
+	client *ent.Client
+}
+
+func NewEpisodeService(client *ent.Client) *EpisodeService {
+	return &EpisodeService{
+		client: client,
+	}
+}
+
+// シーズン・エピソードを持てるメディアタイプか
+func IsSeriesMediaType(mediaType movie.MediaType) bool {
+	return mediaType == movie.MediaTypeTvSeries || mediaType == movie.MediaTypeAnime
+}
+
+// 読み込み済みのシーズン・エピソードから視聴済み話数と総話数を集計
+func EpisodeProgress(seasons []*ent.Season) (watched, total int) {
+	for _, s := range seasons {
+		for _, e := range s.Edges.Episodes {
+			total++
+			if e.WatchedAt != nil {
+				watched++
+			}
+		}
+	}
+	return watched, total
+}
+
+// シーズン一覧取得（エピソード付き）
+func (s *EpisodeService) GetSeasons(ctx context.Context, userID, movieID int) ([]*ent.Season, error) {
+	if _, err := s.getSeries(ctx, s.client, userID, movieID); err != nil {
+		return nil, err
+	}
+
+	query := s.client.Season.Query().Where(season.MovieIDEQ(movieID))
+	withEpisodes(query)
+
+	seasons, err := query.All(ctx)
+	if err != nil {
+		return nil, errors.NewInternalServerError("シーズンの取得に失敗しました")
+	}
+	return seasons, nil
+}
+
+// シーズン作成（指定話数分のエピソードも作成）
+func (s *EpisodeService) CreateSeason(ctx context.Context, userID, movieID int, req *dto.CreateSeasonRequest) (*ent.Season, error) {
+	var sn *ent.Season
+	err := withTx(ctx, s.client, func(tx *ent.Tx) error {
+		client := tx.Client()
+
+		m, err := s.getSeries(ctx, client, userID, movieID)
+		if err != nil {
+			return err
+		}
+
+		builder := client.Season.Create().
+			SetMovieID(movieID).
+			SetNumber(req.Number)
+		if req.Title != "" {
+			builder = builder.SetTitle(req.Title)
+		}
+
+		sn, err = builder.Save(ctx)
+		if err != nil {
+			if ent.IsConstraintError(err) {
+				return errors.NewConflictError("同じ番号のシーズンが既に存在します")
+			}
+			return errors.NewInternalServerError("シーズンの作成に失敗しました")
+		}
+
+		episodes, err := client.Episode.MapCreateBulk(make([]struct{}, req.EpisodeCount), func(c *ent.EpisodeCreate, i int) {
+			c.SetSeasonID(sn.ID).SetNumber(i + 1)
+		}).Save(ctx)
+		if err != nil {
+			return errors.NewInternalServerError("エピソードの作成に失敗しました")
+		}
+		sn.Edges.Episodes = episodes
+
+		// 話数が増えたため視聴ステータスを再計算
+		return s.syncWatchStatus(ctx, client, m)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sn, nil
+}
+
+// シーズン削除（エピソードも削除される）
+func (s *EpisodeService) DeleteSeason(ctx context.Context, userID, movieID, seasonID int) error {
+	return withTx(ctx, s.client, func(tx *ent.Tx) error {
+		client := tx.Client()
+
+		m, err := s.getSeries(ctx, client, userID, movieID)
+		if err != nil {
+			return err
+		}
+
+		err = client.Season.DeleteOneID(seasonID).Where(season.MovieIDEQ(movieID)).Exec(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return errors.NewNotFoundError("シーズンが見つかりません")
+			}
+			return errors.NewInternalServerError("シーズンの削除に失敗しました")
+		}
+
+		return s.syncWatchStatus(ctx, client, m)
+	})
+}
+
+// エピソードの視聴済み・未視聴を切り替え
+func (s *EpisodeService) SetEpisodeWatched(ctx context.Context, userID, movieID, episodeID int, watched bool) (*ent.Movie, error) {
+	return s.updateEpisodes(ctx, userID, movieID, func(client *ent.Client) error {
+		builder := client.Episode.Update().
+			Where(
+				episode.IDEQ(episodeID),
+				episode.HasSeasonWith(season.MovieIDEQ(movieID)),
+			)
+		if watched {
+			builder = builder.SetWatchedAt(time.Now())
+		} else {
+			builder = builder.ClearWatchedAt()
+		}
+
+		n, err := builder.Save(ctx)
+		if err != nil {
+			return errors.NewInternalServerError("エピソードの更新に失敗しました")
+		}
+		if n == 0 {
+			return errors.NewNotFoundError("エピソードが見つかりません")
+		}
+		return nil
+	})
+}
+
+// 指定したシーズン・話数までの未視聴エピソードをまとめて視聴済みにする
+func (s *EpisodeService) WatchEpisodesUpTo(ctx context.Context, userID, movieID int, req *dto.WatchEpisodesRequest) (*ent.Movie, error) {
+	return s.updateEpisodes(ctx, userID, movieID, func(client *ent.Client) error {
+		exists, err := client.Episode.Query().
+			Where(
+				episode.NumberEQ(req.Episode),
+				episode.HasSeasonWith(season.MovieIDEQ(movieID), season.NumberEQ(req.Season)),
+			).
+			Exist(ctx)
+		if err != nil {
+			return errors.NewInternalServerError("エピソードの更新に失敗しました")
+		}
+		if !exists {
+			return errors.NewNotFoundError("エピソードが見つかりません")
+		}
+
+		_, err = client.Episode.Update().
+			Where(
+				episode.WatchedAtIsNil(),
+				episode.Or(
+					episode.HasSeasonWith(season.MovieIDEQ(movieID), season.NumberLT(req.Season)),
+					episode.And(
+						episode.HasSeasonWith(season.MovieIDEQ(movieID), season.NumberEQ(req.Season)),
+						episode.NumberLTE(req.Episode),
+					),
+				),
+			).
+			SetWatchedAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return errors.NewInternalServerError("エピソードの更新に失敗しました")
+		}
+		return nil
+	})
+}
+
+// エピソードを更新し、視聴ステータスを同期した作品を返す
+func (s *EpisodeService) updateEpisodes(ctx context.Context, userID, movieID int, update func(client *ent.Client) error) (*ent.Movie, error) {
+	err := withTx(ctx, s.client, func(tx *ent.Tx) error {
+		m, err := s.getSeries(ctx, tx.Client(), userID, movieID)
+		if err != nil {
+			return err
+		}
+
+		if err := update(tx.Client()); err != nil {
+			return err
+		}
+
+		return s.syncWatchStatus(ctx, tx.Client(), m)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := s.client.Movie.Query().
+		Where(movie.IDEQ(movieID)).
+		WithSeasons(withEpisodes).
+		WithTags(withTagNames).
+		WithWatchEvents(withWatchEventIDs).
+		Only(ctx)
+	if err != nil {
+		return nil, errors.NewInternalServerError("映画の取得に失敗しました")
+	}
+	return m, nil
+}
+
+// 視聴済み話数に応じて視聴ステータスを自動更新
+//   - 一部視聴済み → watching
+//   - 全話視聴済み → completed（視聴履歴に記録）
+//   - 未視聴 → 変更しない
+func (s *EpisodeService) syncWatchStatus(ctx context.Context, client *ent.Client, m *ent.Movie) error {
+	base := client.Episode.Query().
+		Where(episode.HasSeasonWith(season.MovieIDEQ(m.ID)))
+
+	total, err := base.Clone().Count(ctx)
+	if err != nil {
+		return errors.NewInternalServerError("視聴進捗の集計に失敗しました")
+	}
+	watched, err := base.Clone().Where(episode.WatchedAtNotNil()).Count(ctx)
+	if err != nil {
+		return errors.NewInternalServerError("視聴進捗の集計に失敗しました")
+	}
+
+	status := m.WatchStatus
+	switch {
+	case watched == 0:
+		return nil
+	case watched < total:
+		status = movie.WatchStatusWatching
+	default:
+		status = movie.WatchStatusCompleted
+	}
+
+	if status == m.WatchStatus {
+		return nil
+	}
+
+	if err := client.Movie.UpdateOneID(m.ID).SetWatchStatus(status).Exec(ctx); err != nil {
+		return errors.NewInternalServerError("視聴ステータスの更新に失敗しました")
+	}
+	m.WatchStatus = status
+
+	if status == movie.WatchStatusCompleted {
+		return recordWatch(ctx, client, m.ID, time.Now())
+	}
+	return nil
+}
+
+// ユーザーが所有するシリーズ作品（tv_series / anime）を取得
+func (s *EpisodeService) getSeries(ctx context.Context, client *ent.Client, userID, movieID int) (*ent.Movie, error) {
+	m, err := client.Movie.Query().
+		Where(movie.IDEQ(movieID), movie.UserIDEQ(userID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.NewNotFoundError("映画が見つかりません")
+		}
+		return nil, errors.NewInternalServerError("映画の取得に失敗しました")
+	}
+
+	if !IsSeriesMediaType(m.MediaType) {
+		return nil, errors.NewBadRequestError("シーズン・エピソードは tv_series または anime の作品のみ登録できます")
+	}
+	return m, nil
+}
+
+// シーズンとエピソードを番号順に読み込む
+func withEpisodes(q *ent.SeasonQuery) {
+	q.WithEpisodes(func(eq *ent.EpisodeQuery) {
+		eq.Order(ent.Asc(episode.FieldNumber))
+	}).Order(ent.Asc(season.FieldNumber))
+}
@@ -0,0 +1,213 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"watchlist-app/ent"
+	"watchlist-app/ent/movie"
+	"watchlist-app/pkg/csvimport"
+	"watchlist-app/pkg/errors"
+)
+
+// 1回の INSERT で作成する作品数（プレースホルダ数の上限を超えないようにする）
+const importBatchSize = 500
+
+// 取り込み結果の状態
+const (
+	ImportStatusNew       = "new"
+	ImportStatusDuplicate = "duplicate"
+	ImportStatusInvalid   = "invalid"
+)
+
+type ImportService struct {
+	client *ent.Client
+}
+
+func NewImportService(client *ent.Client) *ImportService {
+	return &ImportService{
+		client: client,
+	}
+}
+
+type ImportOptions struct {
+	// true の場合は重複の判定のみ行い、作品を作成しない
+	DryRun bool
+	// 空の場合は CSV の値、CSV にもなければ評価・視聴日があれば completed、なければ want_to_watch
+	WatchStatus string
+}
+
+// CSV の1行分の結果
+type ImportItem struct {
+	Row    *csvimport.Row
+	Status string
+	// 重複している登録済みの作品（CSV 内の重複の場合は 0）
+	DuplicateOf int
+	// 取り込み時に作成された作品
+	MovieID int
+}
+
+// CSV から変換した行を重複を除いて作品として登録する
+// 登録済みの作品とタイトル（大文字・小文字、連続する空白を区別しない）が同じで、公開年が同じかどちらかが未設定の場合を重複とみなす。
+// 新規の行は全て1つのトランザクションで作成し、視聴日がある行は視聴履歴も記録する
+func (s *ImportService) Import(ctx context.Context, userID int, rows []*csvimport.Row, opts ImportOptions) ([]*ImportItem, error) {
+	existing, err := s.client.Movie.Query().
+		Where(movie.UserIDEQ(userID)).
+		Select(movie.FieldID, movie.FieldTitle, movie.FieldReleaseYear).
+		All(ctx)
+	if err != nil {
+		return nil, errors.NewInternalServerError("映画の取得に失敗しました")
+	}
+	known := map[string][]*ent.Movie{}
+	for _, m := range existing {
+		key := importTitleKey(m.Title)
+		known[key] = append(known[key], m)
+	}
+
+	items := make([]*ImportItem, len(rows))
+	seen := map[string][]*csvimport.Row{}
+	for i, row := range rows {
+		item := &ImportItem{Row: row, Status: ImportStatusNew}
+		items[i] = item
+		if row.Err != "" {
+			item.Status = ImportStatusInvalid
+			continue
+		}
+
+		key := importTitleKey(row.Title)
+		for _, m := range known[key] {
+			if sameReleaseYear(m.ReleaseYear, row.ReleaseYear) {
+				item.Status = ImportStatusDuplicate
+				item.DuplicateOf = m.ID
+				break
+			}
+		}
+		for _, prev := range seen[key] {
+			if item.Status == ImportStatusNew && sameReleaseYear(prev.ReleaseYear, row.ReleaseYear) {
+				item.Status = ImportStatusDuplicate
+			}
+		}
+		if item.Status == ImportStatusNew {
+			seen[key] = append(seen[key], row)
+		}
+	}
+
+	if opts.DryRun {
+		return items, nil
+	}
+	err = withTx(ctx, s.client, func(tx *ent.Tx) error {
+		return createImportedMovies(ctx, tx.Client(), userID, items, opts.WatchStatus)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// 新規の行を MovieCreateBulk でまとめて作成する
+func createImportedMovies(ctx context.Context, client *ent.Client, userID int, items []*ImportItem, watchStatus string) error {
+	var pending []*ImportItem
+	for _, item := range items {
+		if item.Status == ImportStatusNew {
+			pending = append(pending, item)
+		}
+	}
+
+	for start := 0; start < len(pending); start += importBatchSize {
+		batch := pending[start:min(start+importBatchSize, len(pending))]
+		builders := make([]*ent.MovieCreate, len(batch))
+		for i, item := range batch {
+			builder, err := newImportedMovie(ctx, client, userID, item.Row, watchStatus)
+			if err != nil {
+				return err
+			}
+			builders[i] = builder
+		}
+		movies, err := client.Movie.CreateBulk(builders...).Save(ctx)
+		if err != nil {
+			return errors.NewInternalServerError("映画の取り込みに失敗しました")
+		}
+
+		// 視聴日がある行は視聴履歴にも記録する（作品の視聴完了日は作成時に設定済み）
+		var events []*ent.WatchEventCreate
+		for i, m := range movies {
+			batch[i].MovieID = m.ID
+			row := batch[i].Row
+			if row.WatchedAt.IsZero() {
+				continue
+			}
+			event := client.WatchEvent.Create().
+				SetMovieID(m.ID).
+				SetWatchedAt(row.WatchedAt)
+			if row.Rating > 0 {
+				event = event.SetRating(row.Rating)
+			}
+			events = append(events, event)
+		}
+		if len(events) > 0 {
+			if err := client.WatchEvent.CreateBulk(events...).Exec(ctx); err != nil {
+				return errors.NewInternalServerError("視聴記録の追加に失敗しました")
+			}
+		}
+	}
+	return nil
+}
+
+func newImportedMovie(ctx context.Context, client *ent.Client, userID int, row *csvimport.Row, watchStatus string) (*ent.MovieCreate, error) {
+	builder := client.Movie.Create().
+		SetOwnerID(userID).
+		SetTitle(row.Title)
+
+	if row.ReleaseYear > 0 {
+		builder = builder.SetReleaseYear(row.ReleaseYear)
+	}
+	if row.Rating > 0 {
+		builder = builder.SetRating(row.Rating)
+	}
+	if row.Review != "" {
+		builder = builder.SetReview(row.Review)
+	}
+	if row.Description != "" {
+		builder = builder.SetDescription(row.Description)
+	}
+	if row.PosterURL != "" {
+		builder = builder.SetPosterURL(row.PosterURL)
+	}
+	if row.Genre != "" {
+		builder = builder.SetGenre(row.Genre)
+	}
+	if row.MediaType != "" {
+		builder = builder.SetMediaType(movie.MediaType(row.MediaType))
+	}
+	if !row.WatchedAt.IsZero() {
+		builder = builder.SetWatchedAt(row.WatchedAt)
+	}
+
+	if watchStatus == "" {
+		watchStatus = row.WatchStatus
+	}
+	if watchStatus == "" {
+		watchStatus = string(movie.WatchStatusWantToWatch)
+		if row.Rating > 0 || !row.WatchedAt.IsZero() {
+			watchStatus = string(movie.WatchStatusCompleted)
+		}
+	}
+	builder = builder.SetWatchStatus(movie.WatchStatus(watchStatus))
+
+	// ジャンルもタグとして登録する
+	tagIDs, err := resolveTagIDs(ctx, client, userID, appendGenre(row.Tags, row.Genre))
+	if err != nil {
+		return nil, err
+	}
+	return builder.AddTagIDs(tagIDs...), nil
+}
+
+// 重複判定用のタイトル（大文字・小文字と空白の違いを無視する）
+func importTitleKey(title string) string {
+	return strings.ToLower(strings.Join(strings.Fields(title), " "))
+}
+
+// 公開年が同じか、どちらかが未設定
+func sameReleaseYear(a, b int) bool {
+	return a == 0 || b == 0 || a == b
+}
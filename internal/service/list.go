@@ -0,0 +1,295 @@
+package service
+
+import (
+	"context"
+
+	"watchlist-app/dto"
+	"watchlist-app/ent"
+	"watchlist-app/ent/list"
+	"watchlist-app/ent/listentry"
+	"watchlist-app/ent/movie"
+	"watchlist-app/pkg/errors"
+)
+
+type ListService struct {
+	client *ent.Client
+}
+
+func NewListService(client *ent.Client) *ListService {
+	return &ListService{
+		client: client,
+	}
+}
+
+// リスト一覧取得（件数算出用にエントリを含む）
+func (s *ListService) GetLists(ctx context.Context, userID int) ([]*ent.List, error) {
+	lists, err := s.client.List.Query().
+		Where(list.UserIDEQ(userID)).
+		WithEntries(func(q *ent.ListEntryQuery) {
+			q.Where(listentry.HasMovieWith(movie.DeletedAtIsNil())).
+				Select(listentry.FieldListID, listentry.FieldMovieID)
+		}).
+		Order(ent.Asc(list.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.NewInternalServerError("リストの取得に失敗しました")
+	}
+	return lists, nil
+}
+
+// リスト詳細取得（並び順どおりの作品を含む）
+func (s *ListService) GetList(ctx context.Context, userID, id int) (*ent.List, error) {
+	l, err := s.client.List.Query().
+		Where(list.IDEQ(id), list.UserIDEQ(userID)).
+		WithEntries(func(q *ent.ListEntryQuery) {
+			q.Where(listentry.HasMovieWith(movie.DeletedAtIsNil())).
+				Order(ent.Asc(listentry.FieldPosition)).
+				WithMovie(func(mq *ent.MovieQuery) {
+					mq.WithSeasons(withEpisodes).
+						WithTags(withTagNames).
+						WithWatchEvents(withWatchEventIDs)
+				})
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.NewNotFoundError("リストが見つかりません")
+		}
+		return nil, errors.NewInternalServerError("リストの取得に失敗しました")
+	}
+	return l, nil
+}
+
+// リスト作成
+func (s *ListService) CreateList(ctx context.Context, userID int, req *dto.ListRequest) (*ent.List, error) {
+	builder := s.client.List.Create().
+		SetUserID(userID).
+		SetName(req.Name)
+	if req.Description != "" {
+		builder = builder.SetDescription(req.Description)
+	}
+
+	l, err := builder.Save(ctx)
+	if err != nil {
+		return nil, errors.NewInternalServerError("リストの作成に失敗しました")
+	}
+	return s.GetList(ctx, userID, l.ID)
+}
+
+// リスト更新
+func (s *ListService) UpdateList(ctx context.Context, userID, id int, req *dto.ListRequest) (*ent.List, error) {
+	builder := s.client.List.UpdateOneID(id).
+		Where(list.UserIDEQ(userID)).
+		SetName(req.Name)
+	if req.Description != "" {
+		builder = builder.SetDescription(req.Description)
+	} else {
+		builder = builder.ClearDescription()
+	}
+
+	if err := builder.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.NewNotFoundError("リストが見つかりません")
+		}
+		return nil, errors.NewInternalServerError("リストの更新に失敗しました")
+	}
+	return s.GetList(ctx, userID, id)
+}
+
+// リスト削除（作品自体は削除されない）
+func (s *ListService) DeleteList(ctx context.Context, userID, id int) error {
+	err := s.client.List.DeleteOneID(id).Where(list.UserIDEQ(userID)).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return errors.NewNotFoundError("リストが見つかりません")
+		}
+		return errors.NewInternalServerError("リストの削除に失敗しました")
+	}
+	return nil
+}
+
+// リストに作品を追加（位置指定時は後続の作品を1つずつ後ろにずらす）
+func (s *ListService) AddMovie(ctx context.Context, userID, listID int, req *dto.AddListMovieRequest) (*ent.List, error) {
+	err := withTx(ctx, s.client, func(tx *ent.Tx) error {
+		client := tx.Client()
+
+		if err := ensureListOwned(ctx, client, userID, listID); err != nil {
+			return err
+		}
+		if err := ensureMovieOwned(ctx, client, userID, req.MovieID); err != nil {
+			return err
+		}
+
+		exists, err := client.ListEntry.Query().
+			Where(listentry.ListIDEQ(listID), listentry.MovieIDEQ(req.MovieID)).
+			Exist(ctx)
+		if err != nil {
+			return errors.NewInternalServerError("リストの更新に失敗しました")
+		}
+		if exists {
+			return errors.NewConflictError("この作品は既にリストに追加されています")
+		}
+
+		count, err := client.ListEntry.Query().
+			Where(listentry.ListIDEQ(listID)).
+			Count(ctx)
+		if err != nil {
+			return errors.NewInternalServerError("リストの更新に失敗しました")
+		}
+
+		position := count
+		if req.Position != nil && *req.Position < count {
+			position = *req.Position
+			_, err := client.ListEntry.Update().
+				Where(listentry.ListIDEQ(listID), listentry.PositionGTE(position)).
+				AddPosition(1).
+				Save(ctx)
+			if err != nil {
+				return errors.NewInternalServerError("リストの更新に失敗しました")
+			}
+		}
+
+		err = client.ListEntry.Create().
+			SetListID(listID).
+			SetMovieID(req.MovieID).
+			SetPosition(position).
+			Exec(ctx)
+		if err != nil {
+			return errors.NewInternalServerError("リストの更新に失敗しました")
+		}
+		return touchList(ctx, client, listID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetList(ctx, userID, listID)
+}
+
+// リストから作品を外す（後続の作品を詰める）
+func (s *ListService) RemoveMovie(ctx context.Context, userID, listID, movieID int) (*ent.List, error) {
+	err := withTx(ctx, s.client, func(tx *ent.Tx) error {
+		client := tx.Client()
+
+		if err := ensureListOwned(ctx, client, userID, listID); err != nil {
+			return err
+		}
+
+		entry, err := client.ListEntry.Query().
+			Where(listentry.ListIDEQ(listID), listentry.MovieIDEQ(movieID)).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return errors.NewNotFoundError("リストにこの作品は含まれていません")
+			}
+			return errors.NewInternalServerError("リストの更新に失敗しました")
+		}
+
+		_, err = client.ListEntry.Delete().
+			Where(listentry.ListIDEQ(listID), listentry.MovieIDEQ(movieID)).
+			Exec(ctx)
+		if err != nil {
+			return errors.NewInternalServerError("リストの更新に失敗しました")
+		}
+
+		_, err = client.ListEntry.Update().
+			Where(listentry.ListIDEQ(listID), listentry.PositionGT(entry.Position)).
+			AddPosition(-1).
+			Save(ctx)
+		if err != nil {
+			return errors.NewInternalServerError("リストの更新に失敗しました")
+		}
+		return touchList(ctx, client, listID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetList(ctx, userID, listID)
+}
+
+// リスト内の並び替え（リスト内の全作品IDを過不足なく指定する）
+func (s *ListService) ReorderMovies(ctx context.Context, userID, listID int, req *dto.ReorderListRequest) (*ent.List, error) {
+	err := withTx(ctx, s.client, func(tx *ent.Tx) error {
+		client := tx.Client()
+
+		if err := ensureListOwned(ctx, client, userID, listID); err != nil {
+			return err
+		}
+
+		current, err := client.ListEntry.Query().
+			Where(listentry.ListIDEQ(listID), listentry.HasMovieWith(movie.DeletedAtIsNil())).
+			Select(listentry.FieldMovieID).
+			Ints(ctx)
+		if err != nil {
+			return errors.NewInternalServerError("リストの更新に失敗しました")
+		}
+
+		if !samePermutation(current, req.MovieIDs) {
+			return errors.NewBadRequestError("movie_ids にはリスト内の全作品IDを重複なく指定してください")
+		}
+
+		// ゴミ箱内の作品は指定された作品の後ろに元の順序で並べる
+		trashed, err := client.ListEntry.Query().
+			Where(listentry.ListIDEQ(listID), listentry.HasMovieWith(movie.DeletedAtNotNil())).
+			Order(ent.Asc(listentry.FieldPosition)).
+			Select(listentry.FieldMovieID).
+			Ints(ctx)
+		if err != nil {
+			return errors.NewInternalServerError("リストの更新に失敗しました")
+		}
+
+		for position, movieID := range append(req.MovieIDs, trashed...) {
+			_, err := client.ListEntry.Update().
+				Where(listentry.ListIDEQ(listID), listentry.MovieIDEQ(movieID)).
+				SetPosition(position).
+				Save(ctx)
+			if err != nil {
+				return errors.NewInternalServerError("リストの更新に失敗しました")
+			}
+		}
+		return touchList(ctx, client, listID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetList(ctx, userID, listID)
+}
+
+// リストがユーザーの所有であることを確認
+func ensureListOwned(ctx context.Context, client *ent.Client, userID, listID int) error {
+	exists, err := client.List.Query().
+		Where(list.IDEQ(listID), list.UserIDEQ(userID)).
+		Exist(ctx)
+	if err != nil {
+		return errors.NewInternalServerError("リストの取得に失敗しました")
+	}
+	if !exists {
+		return errors.NewNotFoundError("リストが見つかりません")
+	}
+	return nil
+}
+
+// リストの更新日時を更新
+func touchList(ctx context.Context, client *ent.Client, listID int) error {
+	if err := client.List.UpdateOneID(listID).Exec(ctx); err != nil {
+		return errors.NewInternalServerError("リストの更新に失敗しました")
+	}
+	return nil
+}
+
+// b が a の並び替え（重複・過不足なし）かどうか
+func samePermutation(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	counts := make(map[int]int, len(a))
+	for _, id := range a {
+		counts[id]++
+	}
+	for _, id := range b {
+		if counts[id] == 0 {
+			return false
+		}
+		counts[id]--
+	}
+	return true
+}
@@ -2,12 +2,19 @@ package service
 
 import (
 	"context"
+	"slices"
+	"strings"
 	"time"
 
 	"watchlist-app/dto"
 	"watchlist-app/ent"
+	"watchlist-app/ent/availability"
 	"watchlist-app/ent/movie"
+	"watchlist-app/ent/platform"
+	"watchlist-app/ent/predicate"
+	"watchlist-app/ent/tag"
 	"watchlist-app/pkg/errors"
+	"watchlist-app/pkg/pagination"
 )
 
 type MovieService struct {
@@ -20,38 +27,153 @@ func NewMovieService(client *ent.Client) *MovieService {
 	}
 }
 
-// 映画リスト取得（フィルタリング付き）
-func (s *MovieService) GetMovies(ctx context.Context, genreFilter, statusFilter, mediaTypeFilter string) ([]*ent.Movie, error) {
-	query := s.client.Movie.Query()
+// 作品一覧の1ページ分
+type MoviePage struct {
+	Movies []*ent.Movie
+	// 条件に一致する全件数
+	Total      int
+	NextCursor string
+	HasMore    bool
+	// 各作品の位置を表すカーソル（Movies と同じ順）
+	Cursors []string
+}
+
+// 映画リスト取得（フィルタリング・カーソルページネーション付き）
+func (s *MovieService) GetMovies(ctx context.Context, userID int, filter *dto.MovieFilter) (*MoviePage, error) {
+	query, order, err := s.movieListQuery(userID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, errors.NewInternalServerError("映画の取得に失敗しました")
+	}
+
+	// カーソル位置より後ろに並ぶ作品
+	if filter.Cursor != "" {
+		cursor, err := pagination.Decode(filter.Cursor)
+		if err != nil {
+			return nil, errors.NewBadRequestError("無効なカーソルです")
+		}
+		after, err := order.after(cursor)
+		if err != nil {
+			return nil, errors.NewBadRequestError("カーソルが並び順と一致しません")
+		}
+		query = query.Where(after)
+	}
+
+	// 次のページの有無を判定するため1件多く取得する
+	limit := pagination.NormalizeLimit(filter.Limit)
+	movies, err := query.
+		WithSeasons(withEpisodes).
+		WithTags(withTagNames).
+		WithWatchEvents(withWatchEventIDs).
+		Order(order.order...).
+		Limit(limit + 1).
+		All(ctx)
+	if err != nil {
+		return nil, errors.NewInternalServerError("映画の取得に失敗しました")
+	}
+
+	page := &MoviePage{Movies: movies, Total: total}
+	if len(movies) > limit {
+		page.Movies = movies[:limit]
+		page.HasMore = true
+	}
+	page.Cursors = make([]string, len(page.Movies))
+	for i, m := range page.Movies {
+		page.Cursors[i] = pagination.Encode(order.cursor(m))
+	}
+	if page.HasMore {
+		page.NextCursor = page.Cursors[limit-1]
+	}
+	return page, nil
+}
+
+// 一覧の絞り込み条件を適用したクエリと並び順（ページネーションは含まない）
+func (s *MovieService) movieListQuery(userID int, filter *dto.MovieFilter) (*ent.MovieQuery, movieListOrder, error) {
+	query := s.client.Movie.Query().Where(movie.UserIDEQ(userID))
 
 	// ジャンルフィルタ
-	if genreFilter != "" {
-		query = query.Where(movie.GenreEQ(genreFilter))
+	if filter.Genre != "" {
+		query = query.Where(movie.GenreEQ(filter.Genre))
 	}
 
 	// ステータスフィルター
-	if statusFilter != "" {
-		status := movie.WatchStatus(statusFilter)
+	if filter.Status != "" {
+		status := movie.WatchStatus(filter.Status)
 		query = query.Where(movie.WatchStatusEQ(status))
 	}
 
 	// メディアタイプフィルタ
-	if mediaTypeFilter != "" {
-		mediaType := movie.MediaType(mediaTypeFilter)
+	if filter.MediaType != "" {
+		mediaType := movie.MediaType(filter.MediaType)
 		query = query.Where(movie.MediaTypeEQ(mediaType))
 	}
 
-	// 作成日時の降順でソート
-	movies, err := query.Order(ent.Desc(movie.FieldCreatedAt)).All(ctx)
+	// タグフィルタ（and: 全てのタグを持つ / or: いずれかのタグを持つ）
+	if len(filter.Tags) > 0 {
+		if filter.TagMode == "or" {
+			query = query.Where(movie.HasTagsWith(tag.NameIn(filter.Tags...)))
+		} else {
+			for _, name := range filter.Tags {
+				query = query.Where(movie.HasTagsWith(tag.NameEQ(name)))
+			}
+		}
+	}
+
+	// 配信サービスフィルタ（現在配信中の作品）
+	if filter.Platform != "" {
+		query = query.Where(movie.HasAvailabilitiesWith(
+			availability.HasPlatformWith(platform.SlugEQ(strings.ToLower(filter.Platform))),
+			availableAt(time.Now()),
+		))
+	}
+
+	// 範囲指定・有無の条件
+	rangePreds, err := movieRangePredicates(filter)
 	if err != nil {
-		return nil, errors.NewInternalServerError("映画の取得に失敗しました")
+		return nil, movieListOrder{}, err
+	}
+	query = query.Where(rangePreds...)
+
+	// フィルタ式
+	if filter.Filter != "" {
+		pred, err := parseMovieFilterQuery(filter.Filter)
+		if err != nil {
+			return nil, movieListOrder{}, err
+		}
+		query = query.Where(pred)
+	}
+
+	// キーワード検索（タイトル・概要・レビュー）
+	terms := searchTerms(filter.Query)
+	if len(terms) > 0 {
+		query = query.Where(movieMatches(terms))
 	}
-	return movies, nil
+
+	// 並び順（キーワード検索で並び順の指定がない場合は関連度順）
+	if len(terms) > 0 && filter.Sort == "" {
+		return query, relevanceOrder(terms), nil
+	}
+	keys, err := parseMovieSort(filter.Sort)
+	if err != nil {
+		return nil, movieListOrder{}, errors.NewBadRequestError(err.Error())
+	}
+	return query, sortKeyOrder(keys), nil
 }
 
 // 映画詳細取得
-func (s *MovieService) GetMovie(ctx context.Context, id int) (*ent.Movie, error) {
-	movie, err := s.client.Movie.Get(ctx, id)
+func (s *MovieService) GetMovie(ctx context.Context, userID, id int) (*ent.Movie, error) {
+	movie, err := s.client.Movie.Query().
+		Where(movie.IDEQ(id), movie.UserIDEQ(userID)).
+		WithSeasons(withEpisodes).
+		WithTags(withTagNames).
+		WithWatchEvents(withWatchEventIDs).
+		WithCredits(withCreditPeople).
+		WithAvailabilities(withPlatforms).
+		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return nil, errors.NewNotFoundError("映画が見つかりません")
@@ -62,8 +184,31 @@ func (s *MovieService) GetMovie(ctx context.Context, id int) (*ent.Movie, error)
 }
 
 // 映画作成
-func (s *MovieService) CreateMovie(ctx context.Context, req *dto.CreateMovieRequest) (*ent.Movie, error) {
-	builder := s.client.Movie.Create().
+func (s *MovieService) CreateMovie(ctx context.Context, userID int, req *dto.CreateMovieRequest) (*ent.Movie, error) {
+	var id int
+	err := withTx(ctx, s.client, func(tx *ent.Tx) error {
+		builder, err := newMovieCreate(ctx, tx.Client(), userID, req)
+		if err != nil {
+			return err
+		}
+
+		movie, err := builder.Save(ctx)
+		if err != nil {
+			return errors.NewInternalServerError("映画の作成に失敗しました")
+		}
+		id = movie.ID
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetMovie(ctx, userID, id)
+}
+
+// 作成リクエストから作成ビルダーを組み立てる（タグは事前に解決する）
+func newMovieCreate(ctx context.Context, client *ent.Client, userID int, req *dto.CreateMovieRequest) (*ent.MovieCreate, error) {
+	builder := client.Movie.Create().
+		SetOwnerID(userID).
 		SetTitle(req.Title).
 		SetMediaType(movie.MediaType(req.MediaType))
 
@@ -82,16 +227,45 @@ func (s *MovieService) CreateMovie(ctx context.Context, req *dto.CreateMovieRequ
 		builder = builder.SetPosterURL(req.PosterURL)
 	}
 
-	movie, err := builder.Save(ctx)
+	// ジャンルもタグとして登録する
+	tagIDs, err := resolveTagIDs(ctx, client, userID, appendGenre(req.Tags, req.Genre))
 	if err != nil {
-		return nil, errors.NewInternalServerError("映画の作成に失敗しました")
+		return nil, err
 	}
-	return movie, nil
+	return builder.AddTagIDs(tagIDs...), nil
 }
 
 // 映画更新
-func (s *MovieService) UpdateMovie(ctx context.Context, id int, req *dto.UpdateMovieRequest) (*ent.Movie, error) {
-	builder := s.client.Movie.UpdateOneID(id)
+func (s *MovieService) UpdateMovie(ctx context.Context, userID, id int, req *dto.UpdateMovieRequest, ifMatch IfMatch) (*ent.Movie, error) {
+	err := withTx(ctx, s.client, func(tx *ent.Tx) error {
+		return updateMovie(ctx, tx.Client(), userID, id, req, ifMatch)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetMovie(ctx, userID, id)
+}
+
+// トランザクション内で作品を更新する
+func updateMovie(ctx context.Context, client *ent.Client, userID, id int, req *dto.UpdateMovieRequest, ifMatch IfMatch) error {
+	if err := ifMatch.require(); err != nil {
+		return err
+	}
+
+	current, err := client.Movie.Query().
+		Where(movie.IDEQ(id), movie.UserIDEQ(userID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return errors.NewNotFoundError("映画が見つかりません")
+		}
+		return errors.NewInternalServerError("映画の更新に失敗しました")
+	}
+	if err := checkMovieVersion(current, ifMatch); err != nil {
+		return err
+	}
+
+	builder := client.Movie.UpdateOneID(id).Where(movieUpdateWhere(userID, ifMatch)...)
 
 	// 更新するフィールドのみ設定
 	if req.Title != "" {
@@ -114,11 +288,6 @@ func (s *MovieService) UpdateMovie(ctx context.Context, id int, req *dto.UpdateM
 	}
 	if req.WatchStatus != "" {
 		builder = builder.SetWatchStatus(movie.WatchStatus(req.WatchStatus))
-
-		// 視聴完了時は視聴完了日を設定
-		if req.WatchStatus == "completed" {
-			builder = builder.SetWatchedAt(time.Now())
-		}
 	}
 	if req.Rating > 0 {
 		builder = builder.SetRating(req.Rating)
@@ -127,21 +296,72 @@ func (s *MovieService) UpdateMovie(ctx context.Context, id int, req *dto.UpdateM
 		builder = builder.SetReview(req.Review)
 	}
 
-	movie, err := builder.Save(ctx)
-	if err != nil {
+	// タグ指定時は置き換え、ジャンルのみ指定時はそのタグを追加
+	if req.Tags != nil {
+		tagIDs, err := resolveTagIDs(ctx, client, userID, appendGenre(req.Tags, req.Genre))
+		if err != nil {
+			return err
+		}
+		builder = builder.ClearTags().AddTagIDs(tagIDs...)
+	} else if req.Genre != "" {
+		tagIDs, err := genreTagIDs(ctx, client, userID, id, req.Genre)
+		if err != nil {
+			return err
+		}
+		builder = builder.AddTagIDs(tagIDs...)
+	}
+
+	if err := builder.Exec(ctx); err != nil {
 		if ent.IsNotFound(err) {
-			return nil, errors.NewNotFoundError("映画が見つかりません")
+			return versionConflictOrNotFound(ifMatch)
 		}
+		return errors.NewInternalServerError("映画の更新に失敗しました")
+	}
+
+	// 視聴完了になった時は視聴履歴に記録する（過去の視聴日は履歴に残る）
+	if req.WatchStatus == string(movie.WatchStatusCompleted) && current.WatchStatus != movie.WatchStatusCompleted {
+		return recordWatch(ctx, client, id, time.Now())
+	}
+	return nil
+}
+
+// ジャンルと同名のタグのうち、作品にまだ付いていないもの
+func genreTagIDs(ctx context.Context, client *ent.Client, userID, movieID int, genre string) ([]int, error) {
+	tagIDs, err := resolveTagIDs(ctx, client, userID, []string{genre})
+	if err != nil {
+		return nil, err
+	}
+	attached, err := client.Movie.Query().
+		Where(movie.IDEQ(movieID), movie.HasTagsWith(tag.IDIn(tagIDs...))).
+		Exist(ctx)
+	if err != nil {
 		return nil, errors.NewInternalServerError("映画の更新に失敗しました")
 	}
-	return movie, nil
+	if attached {
+		return nil, nil
+	}
+	return tagIDs, nil
 }
 
 // 映画削除
-func (s *MovieService) DeleteMovie(ctx context.Context, id int) error {
-	err := s.client.Movie.DeleteOneID(id).Exec(ctx)
+func (s *MovieService) DeleteMovie(ctx context.Context, userID, id int, ifMatch IfMatch) error {
+	return deleteMovie(ctx, s.client, userID, id, ifMatch)
+}
+
+func deleteMovie(ctx context.Context, client *ent.Client, userID, id int, ifMatch IfMatch) error {
+	if err := ifMatch.require(); err != nil {
+		return err
+	}
+
+	err := client.Movie.DeleteOneID(id).Where(movieUpdateWhere(userID, ifMatch)...).Exec(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
+			if len(ifMatch.Versions) > 0 {
+				if err := ensureMovieOwned(ctx, client, userID, id); err != nil {
+					return err
+				}
+				return errors.NewPreconditionFailedError("映画が他の操作で更新されています")
+			}
 			return errors.NewNotFoundError("映画が見つかりません")
 		}
 		return errors.NewInternalServerError("映画の削除に失敗しました")
@@ -149,11 +369,54 @@ func (s *MovieService) DeleteMovie(ctx context.Context, id int) error {
 	return nil
 }
 
+// 作品の更新・削除の条件（REST の If-Match、GraphQL・gRPC・一括操作の version）
+type IfMatch struct {
+	// 取得時のバージョン。現在のバージョンがいずれかと一致する場合のみ更新・削除する
+	Versions []int
+	// If-Match: * の場合。バージョンを確認せずに更新・削除する
+	Any bool
+}
+
+// 他の更新を気付かずに上書きしないよう、バージョンも * も指定されていない場合は 428 とする
+func (m IfMatch) require() error {
+	if len(m.Versions) == 0 && !m.Any {
+		return errors.NewPreconditionRequiredError("更新・削除する映画のバージョンを指定してください")
+	}
+	return nil
+}
+
+// If-Match で指定されたバージョンと現在のバージョンが一致するか確認する
+func checkMovieVersion(current *ent.Movie, ifMatch IfMatch) error {
+	if len(ifMatch.Versions) > 0 && !slices.Contains(ifMatch.Versions, current.Version) {
+		return errors.NewPreconditionFailedError("映画が他の操作で更新されています")
+	}
+	return nil
+}
+
+// 更新・削除の対象の条件。バージョン指定時は、確認後に他の更新があった行を対象外にする
+func movieUpdateWhere(userID int, ifMatch IfMatch) []predicate.Movie {
+	preds := []predicate.Movie{movie.UserIDEQ(userID)}
+	if len(ifMatch.Versions) > 0 {
+		preds = append(preds, movie.VersionIn(ifMatch.Versions...))
+	}
+	return preds
+}
+
+// 存在を確認済みの作品の更新対象が見つからない場合、バージョン指定時は競合として扱う
+func versionConflictOrNotFound(ifMatch IfMatch) error {
+	if len(ifMatch.Versions) > 0 {
+		return errors.NewPreconditionFailedError("映画が他の操作で更新されています")
+	}
+	return errors.NewNotFoundError("映画が見つかりません")
+}
+
 // ジャンル一覧取得（統計用）
-func (s *MovieService) GetGenres(ctx context.Context) ([]string, error) {
-	var genres []string
-	genres, err := s.client.Movie.Query().
-		GroupBy(movie.FieldGenre).
+// ジャンルはタグで管理するため、作品が紐づいているタグ名を返す
+func (s *MovieService) GetGenres(ctx context.Context, userID int) ([]string, error) {
+	genres, err := s.client.Tag.Query().
+		Where(tag.UserIDEQ(userID), tag.HasMoviesWith(movie.DeletedAtIsNil())).
+		Order(ent.Asc(tag.FieldName)).
+		Select(tag.FieldName).
 		Strings(ctx)
 	if err != nil {
 		return nil, errors.NewInternalServerError("ジャンルの取得に失敗しました")
@@ -162,13 +425,14 @@ func (s *MovieService) GetGenres(ctx context.Context) ([]string, error) {
 }
 
 // 視聴統計取得
-func (s *MovieService) GetWatchStats(ctx context.Context) (map[string]int, error) {
+func (s *MovieService) GetWatchStats(ctx context.Context, userID int) (map[string]int, error) {
 	var results []struct {
 		Status movie.WatchStatus `json:"watch_status"`
 		Count  int               `json:"count"`
 	}
 
 	err := s.client.Movie.Query().
+		Where(movie.UserIDEQ(userID)).
 		GroupBy(movie.FieldWatchStatus).
 		Aggregate(ent.Count()).
 		Scan(ctx, &results)
@@ -199,3 +463,16 @@ func (s *MovieService) GetWatchStats(ctx context.Context) (map[string]int, error
 
 	return stats, nil
 }
+
+// タグを名前順に読み込む
+func withTagNames(q *ent.TagQuery) {
+	q.Order(ent.Asc(tag.FieldName))
+}
+
+// ジャンルが指定されていればタグ名一覧に加える
+func appendGenre(tags []string, genre string) []string {
+	if genre == "" {
+		return tags
+	}
+	return append(append([]string{}, tags...), genre)
+}
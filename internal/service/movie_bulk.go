@@ -0,0 +1,221 @@
+package service
+
+import (
+	"context"
+	"net/http"
+
+	"watchlist-app/dto"
+	"watchlist-app/ent"
+	"watchlist-app/ent/movie"
+	"watchlist-app/pkg/errors"
+)
+
+// 一括操作の種類
+const (
+	BulkOpCreate = "create"
+	BulkOpUpdate = "update"
+	BulkOpDelete = "delete"
+)
+
+// 一括操作の1件分
+type BulkOperation struct {
+	Op      string
+	ID      int
+	IfMatch []int
+	Create  *dto.CreateMovieRequest
+	Update  *dto.UpdateMovieRequest
+	// リクエストの検証エラー（設定されている場合は実行しない）
+	Err error
+}
+
+// 一括操作の1件分の結果
+type BulkResult struct {
+	ID int
+	// 作成・更新後の作品（削除・失敗時は nil）
+	Movie *ent.Movie
+	Err   error
+}
+
+// 作品の一括作成・更新・削除
+// 作成を先に行い、その後に更新・削除をリクエストの順に実行する。
+// partial が false の場合は全体を1つのトランザクションで実行し（作成は MovieCreateBulk でまとめて行う）、1件でも失敗すれば全て取り消す。
+// partial が true の場合は1件ずつのトランザクションで実行し、失敗した操作のみ取り消す
+func (s *MovieService) BulkMovies(ctx context.Context, userID int, ops []*BulkOperation, partial bool) ([]*BulkResult, error) {
+	results := make([]*BulkResult, len(ops))
+	for i, op := range ops {
+		results[i] = &BulkResult{ID: op.ID, Err: op.Err}
+	}
+
+	if partial {
+		s.bulkPartial(ctx, userID, ops, results)
+	} else if !hasBulkError(results) {
+		err := withTx(ctx, s.client, func(tx *ent.Tx) error {
+			if err := createBulkMovies(ctx, tx.Client(), userID, ops, results); err != nil {
+				failBulkCreates(ops, results, err)
+				return err
+			}
+			for i, op := range ops {
+				if err := applyBulkOperation(ctx, tx.Client(), userID, op); err != nil {
+					results[i].Err = err
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil && !hasBulkError(results) {
+			// コミットの失敗など、特定の操作によらないエラー
+			return nil, err
+		}
+	}
+
+	// 1件でも失敗した場合、atomic では他の操作も取り消されている
+	if !partial && hasBulkError(results) {
+		for i, r := range results {
+			if r.Err == nil {
+				r.Err = errors.NewAppError(http.StatusFailedDependency, "他の操作が失敗したため取り消されました")
+				if ops[i].Op == BulkOpCreate {
+					r.ID = 0
+				}
+			}
+		}
+		return results, nil
+	}
+
+	if err := s.loadBulkMovies(ctx, ops, results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// 作成・更新・削除を1件ずつのトランザクションで実行する（1件の失敗が他の操作に影響しない）
+func (s *MovieService) bulkPartial(ctx context.Context, userID int, ops []*BulkOperation, results []*BulkResult) {
+	for i, op := range ops {
+		if op.Op != BulkOpCreate || results[i].Err != nil {
+			continue
+		}
+		results[i].Err = withTx(ctx, s.client, func(tx *ent.Tx) error {
+			id, err := createBulkMovie(ctx, tx.Client(), userID, op.Create)
+			results[i].ID = id
+			return err
+		})
+		if results[i].Err != nil {
+			results[i].ID = 0
+		}
+	}
+
+	for i, op := range ops {
+		if op.Op == BulkOpCreate || results[i].Err != nil {
+			continue
+		}
+		results[i].Err = withTx(ctx, s.client, func(tx *ent.Tx) error {
+			return applyBulkOperation(ctx, tx.Client(), userID, op)
+		})
+	}
+}
+
+// 検証済みの作成操作を MovieCreateBulk でまとめて作成し、結果に ID を設定する
+func createBulkMovies(ctx context.Context, client *ent.Client, userID int, ops []*BulkOperation, results []*BulkResult) error {
+	var (
+		builders []*ent.MovieCreate
+		indexes  []int
+	)
+	for i, op := range ops {
+		if op.Op != BulkOpCreate || results[i].Err != nil {
+			continue
+		}
+		builder, err := newMovieCreate(ctx, client, userID, op.Create)
+		if err != nil {
+			return err
+		}
+		builders = append(builders, builder)
+		indexes = append(indexes, i)
+	}
+	if len(builders) == 0 {
+		return nil
+	}
+
+	movies, err := client.Movie.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return errors.NewInternalServerError("映画の作成に失敗しました")
+	}
+	for j, m := range movies {
+		results[indexes[j]].ID = m.ID
+	}
+	return nil
+}
+
+// 作成の1件を実行する（partial で使用する）
+func createBulkMovie(ctx context.Context, client *ent.Client, userID int, req *dto.CreateMovieRequest) (int, error) {
+	builder, err := newMovieCreate(ctx, client, userID, req)
+	if err != nil {
+		return 0, err
+	}
+	m, err := builder.Save(ctx)
+	if err != nil {
+		return 0, errors.NewInternalServerError("映画の作成に失敗しました")
+	}
+	return m.ID, nil
+}
+
+// 更新・削除の1件を実行する（作成は createBulkMovies・createBulkMovie で行う）
+func applyBulkOperation(ctx context.Context, client *ent.Client, userID int, op *BulkOperation) error {
+	switch op.Op {
+	case BulkOpUpdate:
+		return updateMovie(ctx, client, userID, op.ID, op.Update, IfMatch{Versions: op.IfMatch})
+	case BulkOpDelete:
+		return deleteMovie(ctx, client, userID, op.ID, IfMatch{Versions: op.IfMatch})
+	}
+	return nil
+}
+
+// 成功した作成・更新の結果に作品を読み込む
+func (s *MovieService) loadBulkMovies(ctx context.Context, ops []*BulkOperation, results []*BulkResult) error {
+	var ids []int
+	for i, r := range results {
+		if r.Err == nil && ops[i].Op != BulkOpDelete {
+			ids = append(ids, r.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	movies, err := s.client.Movie.Query().
+		Where(movie.IDIn(ids...)).
+		WithSeasons(withEpisodes).
+		WithTags(withTagNames).
+		WithWatchEvents(withWatchEventIDs).
+		All(ctx)
+	if err != nil {
+		return errors.NewInternalServerError("映画の取得に失敗しました")
+	}
+	byID := make(map[int]*ent.Movie, len(movies))
+	for _, m := range movies {
+		byID[m.ID] = m
+	}
+	for i, r := range results {
+		if r.Err == nil && ops[i].Op != BulkOpDelete {
+			r.Movie = byID[r.ID]
+		}
+	}
+	return nil
+}
+
+// atomic の作成はまとめて行い、失敗は特定の1件によらないため、作成する全ての操作を失敗とする
+func failBulkCreates(ops []*BulkOperation, results []*BulkResult, err error) {
+	for i, op := range ops {
+		if op.Op == BulkOpCreate && results[i].Err == nil {
+			results[i].ID = 0
+			results[i].Err = err
+		}
+	}
+}
+
+func hasBulkError(results []*BulkResult) bool {
+	for _, r := range results {
+		if r.Err != nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+
+	"watchlist-app/dto"
+	"watchlist-app/ent"
+	"watchlist-app/ent/predicate"
+	"watchlist-app/pkg/errors"
+)
+
+// エクスポート時に1回のクエリで読み込む作品数
+const exportBatchSize = 200
+
+// 一覧と同じ条件・並び順の全ての作品を順に fn に渡す（limit・cursor は無視する）
+// 全件をメモリに読み込まないよう、一覧のカーソルと同じ方法で少しずつ読み込む
+func (s *MovieService) ExportMovies(ctx context.Context, userID int, filter *dto.MovieFilter, fn func(*ent.Movie) error) error {
+	query, order, err := s.movieListQuery(userID, filter)
+	if err != nil {
+		return err
+	}
+
+	var after predicate.Movie
+	for {
+		batch := query.Clone()
+		if after != nil {
+			batch = batch.Where(after)
+		}
+		movies, err := batch.
+			WithSeasons(withEpisodes).
+			WithTags(withTagNames).
+			WithWatchEvents(withWatchEventIDs).
+			Order(order.order...).
+			Limit(exportBatchSize).
+			All(ctx)
+		if err != nil {
+			return errors.NewInternalServerError("映画の取得に失敗しました")
+		}
+
+		for _, m := range movies {
+			if err := fn(m); err != nil {
+				return err
+			}
+		}
+		if len(movies) < exportBatchSize {
+			return nil
+		}
+
+		after, err = order.after(order.cursor(movies[len(movies)-1]))
+		if err != nil {
+			return errors.NewInternalServerError("映画の取得に失敗しました")
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"time"
+
+	"watchlist-app/dto"
+	"watchlist-app/ent/movie"
+	"watchlist-app/ent/predicate"
+	"watchlist-app/pkg/errors"
+)
+
+// 日付のみで指定された場合の形式（サーバーのタイムゾーンの0時として扱う）
+const filterDateLayout = "2006-01-02"
+
+// 評価・公開年・視聴日の範囲指定と、レビュー・評価の有無の条件
+// 範囲の下限が上限より大きい場合や、矛盾する条件の組み合わせは 400 エラーとする
+func movieRangePredicates(filter *dto.MovieFilter) ([]predicate.Movie, error) {
+	var preds []predicate.Movie
+
+	// 評価
+	if filter.RatingMin > 0 && filter.RatingMax > 0 && filter.RatingMin > filter.RatingMax {
+		return nil, errors.NewBadRequestError("rating_min には rating_max 以下の値を指定してください")
+	}
+	if filter.Unrated == "true" && (filter.RatingMin > 0 || filter.RatingMax > 0) {
+		return nil, errors.NewBadRequestError("unrated=true と rating_min・rating_max は同時に指定できません")
+	}
+	if filter.RatingMin > 0 {
+		preds = append(preds, movie.RatingGTE(filter.RatingMin))
+	}
+	if filter.RatingMax > 0 {
+		preds = append(preds, movie.RatingLTE(filter.RatingMax))
+	}
+	switch filter.Unrated {
+	case "true":
+		preds = append(preds, movie.RatingIsNil())
+	case "false":
+		preds = append(preds, movie.RatingNotNil())
+	}
+
+	// 公開年
+	if filter.YearFrom > 0 && filter.YearTo > 0 && filter.YearFrom > filter.YearTo {
+		return nil, errors.NewBadRequestError("year_from には year_to 以下の値を指定してください")
+	}
+	if filter.YearFrom > 0 {
+		preds = append(preds, movie.ReleaseYearGTE(filter.YearFrom))
+	}
+	if filter.YearTo > 0 {
+		preds = append(preds, movie.ReleaseYearLTE(filter.YearTo))
+	}
+
+	// 視聴日
+	after, err := parseFilterTime("watched_after", filter.WatchedAfter)
+	if err != nil {
+		return nil, err
+	}
+	before, err := parseFilterTime("watched_before", filter.WatchedBefore)
+	if err != nil {
+		return nil, err
+	}
+	if !after.IsZero() && !before.IsZero() && !after.Before(before) {
+		return nil, errors.NewBadRequestError("watched_after には watched_before より前の日時を指定してください")
+	}
+	if !after.IsZero() {
+		preds = append(preds, movie.WatchedAtGTE(after))
+	}
+	if !before.IsZero() {
+		preds = append(preds, movie.WatchedAtLT(before))
+	}
+
+	// レビュー（空文字のレビューも未記入として扱う）
+	switch filter.HasReview {
+	case "true":
+		preds = append(preds, movie.ReviewNotNil(), movie.ReviewNEQ(""))
+	case "false":
+		preds = append(preds, movie.Or(movie.ReviewIsNil(), movie.ReviewEQ("")))
+	}
+
+	return preds, nil
+}
+
+// 日付（2024-01-31）または RFC3339 形式の日時を解析する（未指定はゼロ値）
+func parseFilterTime(name, value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.ParseInLocation(filterDateLayout, value, time.Local); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, errors.NewBadRequestError(name + " は 2024-01-31 形式の日付か RFC3339 形式の日時で指定してください")
+	}
+	return t, nil
+}
@@ -0,0 +1,349 @@
+package service
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"watchlist-app/ent/availability"
+	"watchlist-app/ent/movie"
+	"watchlist-app/ent/platform"
+	"watchlist-app/ent/predicate"
+	"watchlist-app/ent/tag"
+	"watchlist-app/pkg/errors"
+	"watchlist-app/pkg/filterquery"
+)
+
+// フィルタ式の項目名の別名
+var filterFieldAliases = map[string]string{
+	"status":  movie.FieldWatchStatus,
+	"type":    movie.FieldMediaType,
+	"year":    movie.FieldReleaseYear,
+	"watched": movie.FieldWatchedAt,
+	"tags":    "tag",
+	"desc":    movie.FieldDescription,
+	"poster":  movie.FieldPosterURL,
+}
+
+// 部分一致で検索する文字列項目
+var filterTextFields = map[string]func(string) predicate.Movie{
+	movie.FieldTitle:       movie.TitleContainsFold,
+	movie.FieldDescription: movie.DescriptionContainsFold,
+	movie.FieldReview:      movie.ReviewContainsFold,
+}
+
+// 数値で比較する項目
+var filterIntFields = map[string]struct {
+	eq, gt, gte, lt, lte func(int) predicate.Movie
+	isNil, notNil        func() predicate.Movie
+}{
+	movie.FieldRating: {
+		movie.RatingEQ, movie.RatingGT, movie.RatingGTE, movie.RatingLT, movie.RatingLTE,
+		movie.RatingIsNil, movie.RatingNotNil,
+	},
+	movie.FieldReleaseYear: {
+		movie.ReleaseYearEQ, movie.ReleaseYearGT, movie.ReleaseYearGTE, movie.ReleaseYearLT, movie.ReleaseYearLTE,
+		movie.ReleaseYearIsNil, movie.ReleaseYearNotNil,
+	},
+}
+
+// has: で値の有無を判定できる項目（空文字は未設定とみなす）
+var filterPresence = map[string]predicate.Movie{
+	movie.FieldRating:      movie.RatingNotNil(),
+	movie.FieldReleaseYear: movie.ReleaseYearNotNil(),
+	movie.FieldWatchedAt:   movie.WatchedAtNotNil(),
+	movie.FieldGenre:       movie.And(movie.GenreNotNil(), movie.GenreNEQ("")),
+	movie.FieldDescription: movie.And(movie.DescriptionNotNil(), movie.DescriptionNEQ("")),
+	movie.FieldReview:      movie.And(movie.ReviewNotNil(), movie.ReviewNEQ("")),
+	movie.FieldPosterURL:   movie.And(movie.PosterURLNotNil(), movie.PosterURLNEQ("")),
+	"tag":                  movie.HasTags(),
+}
+
+// フィルタ式（?filter=genre:SF rating>=4 year:2010..2020 -media_type:anime）を条件に変換する
+// 解析できない場合は問題のある位置を含めた 400 エラーを返す
+func parseMovieFilterQuery(input string) (predicate.Movie, error) {
+	node, err := filterquery.Parse(input)
+	if err != nil {
+		return nil, errors.NewBadRequestError("filter の指定が正しくありません: " + err.Error())
+	}
+	pred, err := filterNodePredicate(node)
+	if err != nil {
+		return nil, errors.NewBadRequestError("filter の指定が正しくありません: " + err.Error())
+	}
+	return pred, nil
+}
+
+func filterNodePredicate(node filterquery.Node) (predicate.Movie, error) {
+	switch n := node.(type) {
+	case *filterquery.And:
+		preds, err := filterNodePredicates(n.Nodes)
+		if err != nil {
+			return nil, err
+		}
+		return movie.And(preds...), nil
+	case *filterquery.Or:
+		preds, err := filterNodePredicates(n.Nodes)
+		if err != nil {
+			return nil, err
+		}
+		return movie.Or(preds...), nil
+	case *filterquery.Not:
+		pred, err := filterNodePredicate(n.Node)
+		if err != nil {
+			return nil, err
+		}
+		return movie.Not(pred), nil
+	case *filterquery.Cond:
+		return filterCondPredicate(n)
+	}
+	return nil, fmt.Errorf("unexpected filter node %T", node)
+}
+
+func filterNodePredicates(nodes []filterquery.Node) ([]predicate.Movie, error) {
+	preds := make([]predicate.Movie, len(nodes))
+	for i, n := range nodes {
+		pred, err := filterNodePredicate(n)
+		if err != nil {
+			return nil, err
+		}
+		preds[i] = pred
+	}
+	return preds, nil
+}
+
+// 1つの条件を変換する
+// 未設定（NULL）の値を持つ作品でも - による否定が正しく働くよう、条件は NULL にならない形で組み立てる
+func filterCondPredicate(c *filterquery.Cond) (predicate.Movie, error) {
+	field := c.Field
+	if alias, ok := filterFieldAliases[field]; ok {
+		field = alias
+	}
+
+	if contains, ok := filterTextFields[field]; ok {
+		if err := requireOps(c, filterquery.OpEq, filterquery.OpNe); err != nil {
+			return nil, err
+		}
+		pred := contains(c.Value)
+		if presence, ok := filterPresence[field]; ok {
+			pred = movie.And(presence, pred)
+		}
+		return negateIf(c.Op == filterquery.OpNe, pred), nil
+	}
+	if f, ok := filterIntFields[field]; ok {
+		return filterIntPredicate(c, f.eq, f.gt, f.gte, f.lt, f.lte, f.isNil, f.notNil)
+	}
+
+	switch field {
+	case movie.FieldGenre:
+		if err := requireOps(c, filterquery.OpEq, filterquery.OpNe); err != nil {
+			return nil, err
+		}
+		pred := movie.And(movie.GenreNotNil(), movie.GenreEqualFold(c.Value))
+		return negateIf(c.Op == filterquery.OpNe, pred), nil
+	case movie.FieldWatchStatus:
+		if err := requireOps(c, filterquery.OpEq, filterquery.OpNe); err != nil {
+			return nil, err
+		}
+		status := movie.WatchStatus(strings.ToLower(c.Value))
+		if movie.WatchStatusValidator(status) != nil {
+			return nil, condError(c.ValuePos, "%s は want_to_watch・watching・completed・dropped のいずれかを指定してください", c.Field)
+		}
+		return negateIf(c.Op == filterquery.OpNe, movie.WatchStatusEQ(status)), nil
+	case movie.FieldMediaType:
+		if err := requireOps(c, filterquery.OpEq, filterquery.OpNe); err != nil {
+			return nil, err
+		}
+		mediaType := movie.MediaType(strings.ToLower(c.Value))
+		if movie.MediaTypeValidator(mediaType) != nil {
+			return nil, condError(c.ValuePos, "%s は movie・tv_series・documentary・anime のいずれかを指定してください", c.Field)
+		}
+		return negateIf(c.Op == filterquery.OpNe, movie.MediaTypeEQ(mediaType)), nil
+	case movie.FieldWatchedAt:
+		return filterWatchedPredicate(c)
+	case "tag":
+		if err := requireOps(c, filterquery.OpEq, filterquery.OpNe); err != nil {
+			return nil, err
+		}
+		return negateIf(c.Op == filterquery.OpNe, movie.HasTagsWith(tag.NameEQ(c.Value))), nil
+	case "platform":
+		// 現在配信中の作品
+		if err := requireOps(c, filterquery.OpEq, filterquery.OpNe); err != nil {
+			return nil, err
+		}
+		pred := movie.HasAvailabilitiesWith(
+			availability.HasPlatformWith(platform.SlugEQ(strings.ToLower(c.Value))),
+			availableAt(time.Now()),
+		)
+		return negateIf(c.Op == filterquery.OpNe, pred), nil
+	case "has":
+		if err := requireOps(c, filterquery.OpEq); err != nil {
+			return nil, err
+		}
+		target := strings.ToLower(c.Value)
+		if alias, ok := filterFieldAliases[target]; ok {
+			target = alias
+		}
+		pred, ok := filterPresence[target]
+		if !ok {
+			return nil, condError(c.ValuePos, "has に指定できない項目 %s です", c.Value)
+		}
+		return pred, nil
+	}
+	return nil, condError(c.Pos, "不明な項目 %s です", c.Field)
+}
+
+// rating・year の条件（none は未設定を表す）
+func filterIntPredicate(c *filterquery.Cond, eq, gt, gte, lt, lte func(int) predicate.Movie, isNil, notNil func() predicate.Movie) (predicate.Movie, error) {
+	if strings.EqualFold(c.Value, "none") {
+		if err := requireOps(c, filterquery.OpEq, filterquery.OpNe); err != nil {
+			return nil, err
+		}
+		if c.Op == filterquery.OpNe {
+			return notNil(), nil
+		}
+		return isNil(), nil
+	}
+
+	if c.Op == filterquery.OpRange {
+		var preds []predicate.Movie
+		from, to := 0, 0
+		if c.Value != "" {
+			v, err := filterInt(c, c.Value, c.ValuePos)
+			if err != nil {
+				return nil, err
+			}
+			from = v
+			preds = append(preds, gte(v))
+		}
+		if c.To != "" {
+			v, err := filterInt(c, c.To, c.ValuePos+len([]rune(c.Value))+2)
+			if err != nil {
+				return nil, err
+			}
+			to = v
+			preds = append(preds, lte(v))
+		}
+		if c.Value != "" && c.To != "" && from > to {
+			return nil, condError(c.ValuePos, "%s の範囲の下限が上限より大きくなっています", c.Field)
+		}
+		return movie.And(append(preds, notNil())...), nil
+	}
+
+	v, err := filterInt(c, c.Value, c.ValuePos)
+	if err != nil {
+		return nil, err
+	}
+	var pred predicate.Movie
+	switch c.Op {
+	case filterquery.OpEq, filterquery.OpNe:
+		pred = eq(v)
+	case filterquery.OpGt:
+		pred = gt(v)
+	case filterquery.OpGte:
+		pred = gte(v)
+	case filterquery.OpLt:
+		pred = lt(v)
+	case filterquery.OpLte:
+		pred = lte(v)
+	}
+	return negateIf(c.Op == filterquery.OpNe, movie.And(notNil(), pred)), nil
+}
+
+func filterInt(c *filterquery.Cond, value string, pos int) (int, error) {
+	v, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, condError(pos, "%s には整数を指定してください", c.Field)
+	}
+	return v, nil
+}
+
+// 視聴日時の条件。日付は1日単位で扱い、watched:2024-01-31 はその日に視聴した作品になる
+func filterWatchedPredicate(c *filterquery.Cond) (predicate.Movie, error) {
+	if strings.EqualFold(c.Value, "none") {
+		if err := requireOps(c, filterquery.OpEq, filterquery.OpNe); err != nil {
+			return nil, err
+		}
+		if c.Op == filterquery.OpNe {
+			return movie.WatchedAtNotNil(), nil
+		}
+		return movie.WatchedAtIsNil(), nil
+	}
+
+	if c.Op == filterquery.OpRange {
+		preds := []predicate.Movie{movie.WatchedAtNotNil()}
+		var from, to time.Time
+		if c.Value != "" {
+			d, err := filterDate(c, c.Value, c.ValuePos)
+			if err != nil {
+				return nil, err
+			}
+			from = d
+			preds = append(preds, movie.WatchedAtGTE(d))
+		}
+		if c.To != "" {
+			d, err := filterDate(c, c.To, c.ValuePos+len([]rune(c.Value))+2)
+			if err != nil {
+				return nil, err
+			}
+			to = d
+			preds = append(preds, movie.WatchedAtLT(d.AddDate(0, 0, 1)))
+		}
+		if !from.IsZero() && !to.IsZero() && from.After(to) {
+			return nil, condError(c.ValuePos, "%s の範囲の開始日が終了日より後になっています", c.Field)
+		}
+		return movie.And(preds...), nil
+	}
+
+	d, err := filterDate(c, c.Value, c.ValuePos)
+	if err != nil {
+		return nil, err
+	}
+	next := d.AddDate(0, 0, 1)
+	var pred predicate.Movie
+	switch c.Op {
+	case filterquery.OpEq, filterquery.OpNe:
+		pred = movie.And(movie.WatchedAtGTE(d), movie.WatchedAtLT(next))
+	case filterquery.OpGt:
+		pred = movie.WatchedAtGTE(next)
+	case filterquery.OpGte:
+		pred = movie.WatchedAtGTE(d)
+	case filterquery.OpLt:
+		pred = movie.WatchedAtLT(d)
+	case filterquery.OpLte:
+		pred = movie.WatchedAtLT(next)
+	}
+	return negateIf(c.Op == filterquery.OpNe, movie.And(movie.WatchedAtNotNil(), pred)), nil
+}
+
+func filterDate(c *filterquery.Cond, value string, pos int) (time.Time, error) {
+	d, err := time.ParseInLocation(filterDateLayout, value, time.Local)
+	if err != nil {
+		return time.Time{}, condError(pos, "%s には 2024-01-31 形式の日付を指定してください", c.Field)
+	}
+	return d, nil
+}
+
+// 項目に使えない演算子の場合はエラー
+func requireOps(c *filterquery.Cond, ops ...filterquery.Op) error {
+	for _, op := range ops {
+		if c.Op == op {
+			return nil
+		}
+	}
+	if c.Op == filterquery.OpRange {
+		return condError(c.ValuePos, "%s には範囲を指定できません", c.Field)
+	}
+	return condError(c.Pos+len([]rune(c.Field)), "%s には演算子 %s を使えません", c.Field, c.Op)
+}
+
+func negateIf(negate bool, pred predicate.Movie) predicate.Movie {
+	if negate {
+		return movie.Not(pred)
+	}
+	return pred
+}
+
+func condError(pos int, format string, args ...any) error {
+	return &filterquery.SyntaxError{Pos: pos, Msg: fmt.Sprintf(format, args...)}
+}
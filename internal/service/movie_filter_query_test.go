@@ -0,0 +1,74 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"watchlist-app/pkg/errors"
+)
+
+func TestParseMovieFilterQuery(t *testing.T) {
+	valid := []string{
+		`genre:SF rating>=4 year:2010..2020 status:completed -media_type:anime`,
+		`type:tv_series OR type:anime`,
+		`title:"star wars" review!=退屈`,
+		`rating:none`,
+		`rating!=none year:..2000`,
+		`watched:2024-01-31`,
+		`watched:2024-01-01..2024-01-31 watched>2023-12-31`,
+		`tag:SF tags!=ホラー platform:netflix`,
+		`has:review -has:rating has:year has:tags`,
+		`NOT (status:dropped OR STATUS:WATCHING)`,
+	}
+	for _, input := range valid {
+		t.Run(input, func(t *testing.T) {
+			if _, err := parseMovieFilterQuery(input); err != nil {
+				t.Errorf("parseMovieFilterQuery(%q) error: %v", input, err)
+			}
+		})
+	}
+}
+
+// 解析・変換できない場合は 400 とし、メッセージに問題のある位置（1始まりの文字数）を含める
+func TestParseMovieFilterQueryErrors(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		// 構文エラー
+		{`genre:SF OR`, "12文字目: OR の後に条件がありません"},
+		{`(genre:SF`, "1文字目: 対応する ) がありません"},
+		// 項目・値のエラー
+		{`genre:SF colour:red`, "10文字目: 不明な項目 colour です"},
+		{`status:done`, "8文字目: status は want_to_watch・watching・completed・dropped のいずれかを指定してください"},
+		{`type:movies`, "6文字目: type は movie・tv_series・documentary・anime のいずれかを指定してください"},
+		{`rating>=four`, "9文字目: rating には整数を指定してください"},
+		{`year:2010..20x0`, "12文字目: year には整数を指定してください"},
+		{`year:2020..2010`, "6文字目: year の範囲の下限が上限より大きくなっています"},
+		{`watched:2024/01/31`, "9文字目: watched には 2024-01-31 形式の日付を指定してください"},
+		{`watched:2024-02-01..2024-01-01`, "9文字目: watched の範囲の開始日が終了日より後になっています"},
+		{`has:colour`, "5文字目: has に指定できない項目 colour です"},
+		// 演算子の位置は項目名の直後
+		{`genre>SF`, "6文字目: genre には演算子 > を使えません"},
+		{`has!=review`, "4文字目: has には演算子 != を使えません"},
+		{`genre:a..b`, "7文字目: genre には範囲を指定できません"},
+		{`rating<=none`, "7文字目: rating には演算子 <= を使えません"},
+		// 全角文字も1文字として数える
+		{`title:"東京" status:done`, "19文字目: status は"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			_, err := parseMovieFilterQuery(tt.input)
+			appErr, ok := err.(*errors.AppError)
+			if !ok {
+				t.Fatalf("parseMovieFilterQuery(%q) error = %v, want *errors.AppError", tt.input, err)
+			}
+			if appErr.Code != 400 {
+				t.Errorf("Code = %d, want 400", appErr.Code)
+			}
+			if !strings.Contains(appErr.Message, tt.want) {
+				t.Errorf("Message = %q, want it to contain %q", appErr.Message, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"watchlist-app/dto"
+	"watchlist-app/ent/enttest"
+	"watchlist-app/pkg/errors"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// REST 以外から呼ばれた場合も、バージョンの指定がなければ更新・削除しない
+func TestMovieVersionRequired(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	u := client.User.Create().
+		SetEmail("user@example.com").
+		SetPasswordHash("hash").
+		SetName("user").
+		SaveX(ctx)
+	m := client.Movie.Create().SetOwnerID(u.ID).SetTitle("Movie").SaveX(ctx)
+	s := NewMovieService(client)
+
+	calls := map[string]func(IfMatch) error{
+		"UpdateMovie": func(ifMatch IfMatch) error {
+			_, err := s.UpdateMovie(ctx, u.ID, m.ID, &dto.UpdateMovieRequest{Title: "Updated"}, ifMatch)
+			return err
+		},
+		"PatchMovie": func(ifMatch IfMatch) error {
+			_, err := s.PatchMovie(ctx, u.ID, m.ID, &dto.PatchMovieRequest{}, ifMatch)
+			return err
+		},
+		"DeleteMovie": func(ifMatch IfMatch) error {
+			return s.DeleteMovie(ctx, u.ID, m.ID, ifMatch)
+		},
+	}
+	for name, call := range calls {
+		t.Run(name, func(t *testing.T) {
+			err := call(IfMatch{})
+			appErr, ok := err.(*errors.AppError)
+			if !ok || appErr.Code != 428 {
+				t.Fatalf("error = %v, want 428", err)
+			}
+			if n := client.Movie.Query().CountX(ctx); n != 1 {
+				t.Errorf("movie was deleted without a version")
+			}
+			if title := client.Movie.GetX(ctx, m.ID).Title; title != "Movie" {
+				t.Errorf("title = %q, want it unchanged", title)
+			}
+		})
+	}
+
+	// * の場合はバージョンを確認しない
+	if _, err := s.UpdateMovie(ctx, u.ID, m.ID, &dto.UpdateMovieRequest{Title: "Updated"}, IfMatch{Any: true}); err != nil {
+		t.Errorf("UpdateMovie with * error: %v", err)
+	}
+}
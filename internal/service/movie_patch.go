@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"watchlist-app/dto"
+	"watchlist-app/ent"
+	"watchlist-app/ent/movie"
+	"watchlist-app/pkg/errors"
+)
+
+// 映画の部分更新（JSON Merge Patch）
+// null を指定したフィールドはクリアし、全ての変更を1つのトランザクションで適用する
+func (s *MovieService) PatchMovie(ctx context.Context, userID, id int, patch *dto.PatchMovieRequest, ifMatch IfMatch) (*ent.Movie, error) {
+	if err := ifMatch.require(); err != nil {
+		return nil, err
+	}
+	if err := validateMoviePatch(patch); err != nil {
+		return nil, err
+	}
+
+	err := withTx(ctx, s.client, func(tx *ent.Tx) error {
+		current, err := tx.Movie.Query().
+			Where(movie.IDEQ(id), movie.UserIDEQ(userID)).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return errors.NewNotFoundError("映画が見つかりません")
+			}
+			return errors.NewInternalServerError("映画の更新に失敗しました")
+		}
+		if err := checkMovieVersion(current, ifMatch); err != nil {
+			return err
+		}
+
+		builder := tx.Movie.UpdateOneID(id).Where(movieUpdateWhere(userID, ifMatch)...)
+
+		if patch.Title.Set {
+			builder = builder.SetTitle(patch.Title.Value)
+		}
+		// 任意の文字列フィールドは null・空文字のどちらでもクリアする
+		if f := patch.Description; f.Set {
+			if f.Null || f.Value == "" {
+				builder = builder.ClearDescription()
+			} else {
+				builder = builder.SetDescription(f.Value)
+			}
+		}
+		if f := patch.Genre; f.Set {
+			if f.Null || f.Value == "" {
+				builder = builder.ClearGenre()
+			} else {
+				builder = builder.SetGenre(f.Value)
+			}
+		}
+		if f := patch.ReleaseYear; f.Set {
+			if f.Null {
+				builder = builder.ClearReleaseYear()
+			} else {
+				builder = builder.SetReleaseYear(f.Value)
+			}
+		}
+		if f := patch.PosterURL; f.Set {
+			if f.Null || f.Value == "" {
+				builder = builder.ClearPosterURL()
+			} else {
+				builder = builder.SetPosterURL(f.Value)
+			}
+		}
+		if patch.MediaType.Set {
+			builder = builder.SetMediaType(movie.MediaType(patch.MediaType.Value))
+		}
+		if patch.WatchStatus.Set {
+			builder = builder.SetWatchStatus(movie.WatchStatus(patch.WatchStatus.Value))
+		}
+		if f := patch.Rating; f.Set {
+			if f.Null {
+				builder = builder.ClearRating()
+			} else {
+				builder = builder.SetRating(f.Value)
+			}
+		}
+		if f := patch.Review; f.Set {
+			if f.Null || f.Value == "" {
+				builder = builder.ClearReview()
+			} else {
+				builder = builder.SetReview(f.Value)
+			}
+		}
+		// 視聴履歴は残したまま視聴完了日のみクリアする
+		if patch.WatchedAt.Null {
+			builder = builder.ClearWatchedAt()
+		}
+
+		// タグ指定時は置き換え、ジャンルのみ指定時はそのタグを追加
+		if f := patch.Tags; f.Set {
+			names := f.Value
+			if patch.Genre.Set && !patch.Genre.Null {
+				names = appendGenre(names, patch.Genre.Value)
+			}
+			builder = builder.ClearTags()
+			if len(names) > 0 {
+				tagIDs, err := resolveTagIDs(ctx, tx.Client(), userID, names)
+				if err != nil {
+					return err
+				}
+				builder = builder.AddTagIDs(tagIDs...)
+			}
+		} else if patch.Genre.Set && patch.Genre.Value != "" {
+			tagIDs, err := genreTagIDs(ctx, tx.Client(), userID, id, patch.Genre.Value)
+			if err != nil {
+				return err
+			}
+			builder = builder.AddTagIDs(tagIDs...)
+		}
+
+		if err := builder.Exec(ctx); err != nil {
+			if ent.IsNotFound(err) {
+				return versionConflictOrNotFound(ifMatch)
+			}
+			if ent.IsValidationError(err) {
+				return errors.NewBadRequestError("入力値が正しくありません: " + err.Error())
+			}
+			return errors.NewInternalServerError("映画の更新に失敗しました")
+		}
+
+		// 視聴日時の指定は視聴記録として追加する
+		if f := patch.WatchedAt; f.Set && !f.Null {
+			return recordWatch(ctx, tx.Client(), id, f.Value)
+		}
+		// 視聴完了になった時は視聴履歴に記録する
+		if patch.WatchStatus.Value == string(movie.WatchStatusCompleted) && current.WatchStatus != movie.WatchStatusCompleted {
+			return recordWatch(ctx, tx.Client(), id, time.Now())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetMovie(ctx, userID, id)
+}
+
+// 更新リクエストと同じ制約を確認する（必須フィールドは null を受け付けない）
+func validateMoviePatch(patch *dto.PatchMovieRequest) error {
+	if f := patch.Title; f.Set && (f.Null || f.Value == "") {
+		return errors.NewBadRequestError("title は空にできません")
+	}
+	if f := patch.MediaType; f.Set {
+		if f.Null || movie.MediaTypeValidator(movie.MediaType(f.Value)) != nil {
+			return errors.NewBadRequestError("media_type は movie・tv_series・documentary・anime のいずれかを指定してください")
+		}
+	}
+	if f := patch.WatchStatus; f.Set {
+		if f.Null || movie.WatchStatusValidator(movie.WatchStatus(f.Value)) != nil {
+			return errors.NewBadRequestError("watch_status は want_to_watch・watching・completed・dropped のいずれかを指定してください")
+		}
+	}
+	if f := patch.Genre; f.Set && !f.Null && (utf8.RuneCountInString(f.Value) > 50 || strings.Contains(f.Value, ",")) {
+		return errors.NewBadRequestError("genre はカンマを含まない50文字以内で指定してください")
+	}
+	if f := patch.ReleaseYear; f.Set && !f.Null && f.Value <= 0 {
+		return errors.NewBadRequestError("release_year には正の整数を指定してください")
+	}
+	if f := patch.Rating; f.Set && !f.Null && (f.Value < 1 || f.Value > 5) {
+		return errors.NewBadRequestError("rating には 1〜5 を指定してください")
+	}
+	if f := patch.Tags; f.Set {
+		if len(f.Value) > 20 {
+			return errors.NewBadRequestError("tags は20個まで指定できます")
+		}
+		for _, name := range f.Value {
+			if name == "" || utf8.RuneCountInString(name) > 50 || strings.Contains(name, ",") {
+				return errors.NewBadRequestError("tags にはカンマを含まない1〜50文字のタグ名を指定してください")
+			}
+		}
+	}
+	return nil
+}
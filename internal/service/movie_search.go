@@ -0,0 +1,145 @@
+package service
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+
+	"watchlist-app/ent"
+	"watchlist-app/ent/movie"
+	"watchlist-app/ent/predicate"
+	"watchlist-app/pkg/pagination"
+)
+
+const (
+	// 検索語の最大数（それ以降は無視する）
+	maxSearchTerms = 5
+	// 関連度を取得する列の別名
+	relevanceColumn = "relevance"
+)
+
+// 関連度の重み（検索語ごとに、一致した列の重みを加算する）
+var searchWeights = []struct {
+	Field  string
+	Weight int
+}{
+	{movie.FieldTitle, 3},
+	{movie.FieldReview, 2},
+	{movie.FieldDescription, 1},
+}
+
+// タイトルが検索語全体と一致する場合の加点
+const exactTitleWeight = 5
+
+// 検索文字列を検索語に分割する（半角・全角スペース区切り）
+// 日本語は単語の区切りがないため、形態素解析はせず各検索語の部分一致で検索する
+func searchTerms(q string) []string {
+	terms := strings.Fields(q)
+	if len(terms) > maxSearchTerms {
+		terms = terms[:maxSearchTerms]
+	}
+	return terms
+}
+
+// 全ての検索語がタイトル・概要・レビューのいずれかに含まれる作品
+// PostgreSQL では ILIKE となり、pg_trgm の GIN インデックスが使われる
+func movieMatches(terms []string) predicate.Movie {
+	preds := make([]predicate.Movie, len(terms))
+	for i, t := range terms {
+		preds[i] = movie.Or(
+			movie.TitleContainsFold(t),
+			movie.DescriptionContainsFold(t),
+			movie.ReviewContainsFold(t),
+		)
+	}
+	return movie.And(preds...)
+}
+
+// 関連度の式。一致した列の重みの合計と、タイトル完全一致の加点
+func relevanceExpr(s *sql.Selector, terms []string) func(*sql.Builder) {
+	return func(b *sql.Builder) {
+		b.WriteString("(CASE WHEN ")
+		b.Join(sql.EqualFold(s.C(movie.FieldTitle), strings.Join(terms, " ")))
+		b.WriteString(" THEN ").WriteString(strconv.Itoa(exactTitleWeight)).WriteString(" ELSE 0 END")
+		for _, t := range terms {
+			for _, w := range searchWeights {
+				b.WriteString(" + CASE WHEN ")
+				b.Join(sql.ContainsFold(s.C(w.Field), t))
+				b.WriteString(" THEN ").WriteString(strconv.Itoa(w.Weight)).WriteString(" ELSE 0 END")
+			}
+		}
+		b.WriteString(")")
+	}
+}
+
+// 関連度の高い順（同じ関連度は ID の降順）
+// 関連度はカーソル作成のため relevance 列として取得する
+func relevanceOrder(terms []string) movieListOrder {
+	spec := relevanceColumn + ":" + strings.Join(terms, " ")
+	return movieListOrder{
+		order: []movie.OrderOption{
+			func(s *sql.Selector) {
+				s.AppendSelectExprAs(sql.ExprFunc(relevanceExpr(s, terms)), relevanceColumn)
+				// ORDER BY 句の式の引数はクエリに含まれないため、取得した列の別名で並べる
+				s.OrderExprFunc(func(b *sql.Builder) {
+					b.Ident(relevanceColumn).WriteString(" DESC")
+				})
+			},
+			movie.ByID(sql.OrderDesc()),
+		},
+		after: func(c pagination.Cursor) (predicate.Movie, error) {
+			if c.Sort != spec || len(c.Values) != 1 {
+				return nil, pagination.ErrInvalidCursor
+			}
+			n, ok := c.Values[0].(json.Number)
+			if !ok {
+				return nil, pagination.ErrInvalidCursor
+			}
+			score, err := n.Int64()
+			if err != nil {
+				return nil, pagination.ErrInvalidCursor
+			}
+
+			return func(s *sql.Selector) {
+				expr := relevanceExpr(s, terms)
+				s.Where(sql.Or(
+					sql.P(func(b *sql.Builder) {
+						expr(b)
+						b.WriteString(" < ").Arg(score)
+					}),
+					sql.And(
+						sql.P(func(b *sql.Builder) {
+							expr(b)
+							b.WriteString(" = ").Arg(score)
+						}),
+						sql.LT(s.C(movie.FieldID), c.ID),
+					),
+				))
+			}, nil
+		},
+		cursor: func(m *ent.Movie) pagination.Cursor {
+			return pagination.Cursor{Sort: spec, Values: []any{movieRelevance(m)}, ID: m.ID}
+		},
+	}
+}
+
+// 取得時に計算した関連度
+func movieRelevance(m *ent.Movie) int64 {
+	v, err := m.Value(relevanceColumn)
+	if err != nil {
+		return 0
+	}
+	switch n := v.(type) {
+	case int64:
+		return n
+	case float64:
+		return int64(n)
+	case []byte:
+		var i int64
+		_ = json.Unmarshal(n, &i)
+		return i
+	}
+	return 0
+}
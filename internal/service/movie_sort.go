@@ -0,0 +1,270 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	"watchlist-app/ent"
+	"watchlist-app/ent/movie"
+	"watchlist-app/ent/predicate"
+	"watchlist-app/pkg/pagination"
+)
+
+// 既定の並び順（作成日時の降順）
+const defaultMovieSort = "-" + movie.FieldCreatedAt
+
+// 並び順に指定できない列（長文・内部用の列）
+var unsortableMovieColumns = map[string]bool{
+	movie.FieldID:          true,
+	movie.FieldDescription: true,
+	movie.FieldReview:      true,
+	movie.FieldPosterURL:   true,
+	movie.FieldDeletedAt:   true,
+	movie.FieldUserID:      true,
+	movie.FieldVersion:     true,
+}
+
+// 並び順に指定できる列（movie.Columns のうち unsortableMovieColumns 以外）
+var sortableMovieColumns = func() map[string]bool {
+	columns := make(map[string]bool, len(movie.Columns))
+	for _, c := range movie.Columns {
+		if !unsortableMovieColumns[c] {
+			columns[c] = true
+		}
+	}
+	return columns
+}()
+
+// 任意入力の列。未設定は NULL として保存され、昇順・降順とも末尾に並べる
+var nullableMovieColumns = map[string]bool{
+	movie.FieldGenre:       true,
+	movie.FieldReleaseYear: true,
+	movie.FieldRating:      true,
+	movie.FieldWatchedAt:   true,
+}
+
+// 作品一覧の並び順（ORDER BY、カーソル位置より後ろの条件、最後の行からのカーソル作成）
+type movieListOrder struct {
+	order  []movie.OrderOption
+	after  func(pagination.Cursor) (predicate.Movie, error)
+	cursor func(*ent.Movie) pagination.Cursor
+}
+
+// 並び順のキー
+type movieSortKey struct {
+	Field string
+	Desc  bool
+}
+
+// ?sort=-rating,release_year,title 形式の並び順を解析する（先頭の - は降順）
+func parseMovieSort(s string) ([]movieSortKey, error) {
+	if strings.TrimSpace(s) == "" {
+		s = defaultMovieSort
+	}
+
+	var keys []movieSortKey
+	seen := map[string]bool{}
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		key := movieSortKey{Field: strings.TrimPrefix(strings.TrimPrefix(part, "-"), "+")}
+		key.Desc = strings.HasPrefix(part, "-")
+
+		if !sortableMovieColumns[key.Field] {
+			return nil, fmt.Errorf("並び替えできない項目です: %s", key.Field)
+		}
+		if seen[key.Field] {
+			return nil, fmt.Errorf("並び順の項目が重複しています: %s", key.Field)
+		}
+		seen[key.Field] = true
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// 指定された列による並び順
+func sortKeyOrder(keys []movieSortKey) movieListOrder {
+	return movieListOrder{
+		order: movieOrder(keys),
+		after: func(c pagination.Cursor) (predicate.Movie, error) {
+			return movieAfterCursor(keys, c)
+		},
+		cursor: func(m *ent.Movie) pagination.Cursor {
+			return newMovieCursor(m, keys)
+		},
+	}
+}
+
+// 並び順を正規化した文字列（カーソルとリクエストの並び順の照合に使う）
+func movieSortSpec(keys []movieSortKey) string {
+	parts := make([]string, len(keys))
+	for i, k := range keys {
+		if k.Desc {
+			parts[i] = "-" + k.Field
+		} else {
+			parts[i] = k.Field
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
+// 並び順の ORDER BY（同じ値の場合は ID の降順）
+func movieOrder(keys []movieSortKey) []movie.OrderOption {
+	orders := make([]movie.OrderOption, 0, len(keys)+1)
+	for _, k := range keys {
+		var opts []sql.OrderTermOption
+		if k.Desc {
+			opts = append(opts, sql.OrderDesc())
+		}
+		if nullableMovieColumns[k.Field] {
+			opts = append(opts, sql.OrderNullsLast())
+		}
+		orders = append(orders, sql.OrderByField(k.Field, opts...).ToFunc())
+	}
+	return append(orders, movie.ByID(sql.OrderDesc()))
+}
+
+// 作品の並び順キーの値からカーソルを作成する
+func newMovieCursor(m *ent.Movie, keys []movieSortKey) pagination.Cursor {
+	values := make([]any, len(keys))
+	for i, k := range keys {
+		values[i] = movieSortValue(m, k.Field)
+	}
+	return pagination.Cursor{Sort: movieSortSpec(keys), Values: values, ID: m.ID}
+}
+
+// 並び順キーの値（任意入力の列が未設定の場合は nil）
+func movieSortValue(m *ent.Movie, field string) any {
+	switch field {
+	case movie.FieldTitle:
+		return m.Title
+	case movie.FieldGenre:
+		if m.Genre == "" {
+			return nil
+		}
+		return m.Genre
+	case movie.FieldReleaseYear:
+		if m.ReleaseYear == 0 {
+			return nil
+		}
+		return m.ReleaseYear
+	case movie.FieldMediaType:
+		return string(m.MediaType)
+	case movie.FieldWatchStatus:
+		return string(m.WatchStatus)
+	case movie.FieldRating:
+		if m.Rating == 0 {
+			return nil
+		}
+		return m.Rating
+	case movie.FieldWatchedAt:
+		if m.WatchedAt.IsZero() {
+			return nil
+		}
+		return m.WatchedAt
+	case movie.FieldCreatedAt:
+		return m.CreatedAt
+	case movie.FieldUpdatedAt:
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+// カーソルから復元した値を列の型に変換する
+func parseMovieSortValue(field string, v any) (any, error) {
+	if v == nil {
+		if !nullableMovieColumns[field] {
+			return nil, pagination.ErrInvalidCursor
+		}
+		return nil, nil
+	}
+
+	switch field {
+	case movie.FieldReleaseYear, movie.FieldRating:
+		n, ok := v.(json.Number)
+		if !ok {
+			return nil, pagination.ErrInvalidCursor
+		}
+		i, err := n.Int64()
+		if err != nil {
+			return nil, pagination.ErrInvalidCursor
+		}
+		return int(i), nil
+	case movie.FieldWatchedAt, movie.FieldCreatedAt, movie.FieldUpdatedAt:
+		s, ok := v.(string)
+		if !ok {
+			return nil, pagination.ErrInvalidCursor
+		}
+		t, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return nil, pagination.ErrInvalidCursor
+		}
+		return t, nil
+	default:
+		s, ok := v.(string)
+		if !ok {
+			return nil, pagination.ErrInvalidCursor
+		}
+		return s, nil
+	}
+}
+
+// カーソル位置より後ろに並ぶ作品の条件
+// キー k1..kn、ID について「k1..k(i-1) が等しく ki が後ろ」のいずれか、または全キーが等しく ID が小さい
+func movieAfterCursor(keys []movieSortKey, cursor pagination.Cursor) (predicate.Movie, error) {
+	if cursor.Sort != movieSortSpec(keys) || len(cursor.Values) != len(keys) {
+		return nil, pagination.ErrInvalidCursor
+	}
+
+	values := make([]any, len(keys))
+	for i, k := range keys {
+		v, err := parseMovieSortValue(k.Field, cursor.Values[i])
+		if err != nil {
+			return nil, err
+		}
+		values[i] = v
+	}
+
+	return func(s *sql.Selector) {
+		equal := func(i int) *sql.Predicate {
+			if values[i] == nil {
+				return sql.IsNull(s.C(keys[i].Field))
+			}
+			return sql.EQ(s.C(keys[i].Field), values[i])
+		}
+
+		var conditions []*sql.Predicate
+		for i, k := range keys {
+			// NULL は末尾に並ぶため、NULL より後ろには同じ NULL しかない
+			if values[i] == nil {
+				continue
+			}
+			var after *sql.Predicate
+			if k.Desc {
+				after = sql.LT(s.C(k.Field), values[i])
+			} else {
+				after = sql.GT(s.C(k.Field), values[i])
+			}
+			if nullableMovieColumns[k.Field] {
+				after = sql.Or(after, sql.IsNull(s.C(k.Field)))
+			}
+
+			preds := make([]*sql.Predicate, 0, i+1)
+			for j := 0; j < i; j++ {
+				preds = append(preds, equal(j))
+			}
+			conditions = append(conditions, sql.And(append(preds, after)...))
+		}
+
+		preds := make([]*sql.Predicate, 0, len(keys)+1)
+		for i := range keys {
+			preds = append(preds, equal(i))
+		}
+		conditions = append(conditions, sql.And(append(preds, sql.LT(s.C(movie.FieldID), cursor.ID))...))
+
+		s.Where(sql.Or(conditions...))
+	}, nil
+}
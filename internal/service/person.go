@@ -0,0 +1,347 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"watchlist-app/dto"
+	"watchlist-app/ent"
+	"watchlist-app/ent/credit"
+	"watchlist-app/ent/movie"
+	"watchlist-app/ent/person"
+	"watchlist-app/pkg/errors"
+)
+
+const defaultPersonStatsLimit = 10
+
+type PersonService struct {
+	client *ent.Client
+}
+
+func NewPersonService(client *ent.Client) *PersonService {
+	return &PersonService{
+		client: client,
+	}
+}
+
+// 人物一覧取得（名前で部分一致検索、担当作品数の算出用にクレジットを含む）
+func (s *PersonService) GetPeople(ctx context.Context, userID int, filter *dto.PersonFilter) ([]*ent.Person, error) {
+	query := s.client.Person.Query().
+		Where(person.UserIDEQ(userID))
+	if q := strings.TrimSpace(filter.Query); q != "" {
+		query = query.Where(person.NameContainsFold(q))
+	}
+
+	people, err := query.
+		WithCredits(withActiveCredits).
+		Order(ent.Asc(person.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.NewInternalServerError("人物の取得に失敗しました")
+	}
+	return people, nil
+}
+
+// 人物詳細取得
+func (s *PersonService) GetPerson(ctx context.Context, userID, id int) (*ent.Person, error) {
+	p, err := s.client.Person.Query().
+		Where(person.IDEQ(id), person.UserIDEQ(userID)).
+		WithCredits(withActiveCredits).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.NewNotFoundError("人物が見つかりません")
+		}
+		return nil, errors.NewInternalServerError("人物の取得に失敗しました")
+	}
+	return p, nil
+}
+
+// 人物作成
+func (s *PersonService) CreatePerson(ctx context.Context, userID int, req *dto.PersonRequest) (*ent.Person, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, errors.NewBadRequestError("名前を入力してください")
+	}
+
+	p, err := s.client.Person.Create().
+		SetUserID(userID).
+		SetName(name).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, errors.NewConflictError("同じ名前の人物が既に存在します")
+		}
+		return nil, errors.NewInternalServerError("人物の作成に失敗しました")
+	}
+	return s.GetPerson(ctx, userID, p.ID)
+}
+
+// 人物名変更
+func (s *PersonService) UpdatePerson(ctx context.Context, userID, id int, req *dto.PersonRequest) (*ent.Person, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, errors.NewBadRequestError("名前を入力してください")
+	}
+
+	err := s.client.Person.UpdateOneID(id).
+		Where(person.UserIDEQ(userID)).
+		SetName(name).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.NewNotFoundError("人物が見つかりません")
+		}
+		if ent.IsConstraintError(err) {
+			return nil, errors.NewConflictError("同じ名前の人物が既に存在します")
+		}
+		return nil, errors.NewInternalServerError("人物の更新に失敗しました")
+	}
+	return s.GetPerson(ctx, userID, id)
+}
+
+// 人物削除（クレジットも削除される）
+func (s *PersonService) DeletePerson(ctx context.Context, userID, id int) error {
+	err := s.client.Person.DeleteOneID(id).Where(person.UserIDEQ(userID)).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return errors.NewNotFoundError("人物が見つかりません")
+		}
+		return errors.NewInternalServerError("人物の削除に失敗しました")
+	}
+	return nil
+}
+
+// 人物の担当作品一覧（視聴リスト内のみ、公開年の新しい順）
+func (s *PersonService) GetFilmography(ctx context.Context, userID, personID int) ([]*ent.Credit, error) {
+	if _, err := s.GetPerson(ctx, userID, personID); err != nil {
+		return nil, err
+	}
+
+	credits, err := s.client.Credit.Query().
+		Where(credit.PersonIDEQ(personID), credit.HasMovieWith(movie.DeletedAtIsNil())).
+		WithMovie(func(q *ent.MovieQuery) {
+			q.WithTags(withTagNames).
+				WithWatchEvents(withWatchEventIDs)
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, errors.NewInternalServerError("担当作品の取得に失敗しました")
+	}
+
+	sort.SliceStable(credits, func(i, j int) bool {
+		a, b := credits[i].Edges.Movie, credits[j].Edges.Movie
+		if a.ReleaseYear != b.ReleaseYear {
+			return a.ReleaseYear > b.ReleaseYear
+		}
+		return a.Title < b.Title
+	})
+	return credits, nil
+}
+
+// 作品のクレジット一覧取得
+func (s *PersonService) GetCredits(ctx context.Context, userID, movieID int) ([]*ent.Credit, error) {
+	if err := ensureMovieOwned(ctx, s.client, userID, movieID); err != nil {
+		return nil, err
+	}
+
+	credits, err := s.client.Credit.Query().
+		Where(credit.MovieIDEQ(movieID)).
+		WithPerson().
+		Order(ent.Asc(credit.FieldRole), ent.Asc(credit.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.NewInternalServerError("クレジットの取得に失敗しました")
+	}
+	return credits, nil
+}
+
+// 作品にクレジットを追加（person_name 指定時は未登録の人物を作成する）
+func (s *PersonService) AddCredit(ctx context.Context, userID, movieID int, req *dto.CreditRequest) (*ent.Credit, error) {
+	role := credit.Role(req.Role)
+	if req.Character != "" && role != credit.RoleActor {
+		return nil, errors.NewBadRequestError("役名は出演（actor）の場合のみ指定できます")
+	}
+
+	var id int
+	err := withTx(ctx, s.client, func(tx *ent.Tx) error {
+		client := tx.Client()
+		if err := ensureMovieOwned(ctx, client, userID, movieID); err != nil {
+			return err
+		}
+
+		personID, err := resolvePersonID(ctx, client, userID, req)
+		if err != nil {
+			return err
+		}
+
+		builder := client.Credit.Create().
+			SetMovieID(movieID).
+			SetPersonID(personID).
+			SetRole(role)
+		if req.Character != "" {
+			builder = builder.SetCharacter(req.Character)
+		}
+
+		c, err := builder.Save(ctx)
+		if err != nil {
+			if ent.IsConstraintError(err) {
+				return errors.NewConflictError("この人物は既に同じ役割で登録されています")
+			}
+			return errors.NewInternalServerError("クレジットの追加に失敗しました")
+		}
+		id = c.ID
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := s.client.Credit.Query().
+		Where(credit.IDEQ(id)).
+		WithPerson().
+		Only(ctx)
+	if err != nil {
+		return nil, errors.NewInternalServerError("クレジットの取得に失敗しました")
+	}
+	return c, nil
+}
+
+// 作品からクレジットを削除（人物自体は残る）
+func (s *PersonService) DeleteCredit(ctx context.Context, userID, movieID, creditID int) error {
+	if err := ensureMovieOwned(ctx, s.client, userID, movieID); err != nil {
+		return err
+	}
+
+	n, err := s.client.Credit.Delete().
+		Where(credit.IDEQ(creditID), credit.MovieIDEQ(movieID)).
+		Exec(ctx)
+	if err != nil {
+		return errors.NewInternalServerError("クレジットの削除に失敗しました")
+	}
+	if n == 0 {
+		return errors.NewNotFoundError("クレジットが見つかりません")
+	}
+	return nil
+}
+
+// 人物別の視聴統計（視聴回数の多い順）
+// role 指定時はその役割でのクレジットのみを集計する（例: よく観ている監督）
+func (s *PersonService) GetPersonStats(ctx context.Context, userID int, filter *dto.PersonStatsFilter) ([]*dto.PersonStatResponse, error) {
+	query := s.client.Credit.Query().
+		Where(credit.HasMovieWith(movie.UserIDEQ(userID), movie.DeletedAtIsNil()))
+	if filter.Role != "" {
+		query = query.Where(credit.RoleEQ(credit.Role(filter.Role)))
+	}
+
+	credits, err := query.
+		WithPerson().
+		WithMovie(func(q *ent.MovieQuery) {
+			q.Select(movie.FieldID).
+				WithWatchEvents(withWatchEventIDs)
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, errors.NewInternalServerError("統計情報の取得に失敗しました")
+	}
+
+	// 監督と出演を兼ねる作品などを重複して数えないよう、人物ごとに作品IDで集計する
+	stats := map[int]*dto.PersonStatResponse{}
+	seen := map[int]map[int]bool{}
+	for _, c := range credits {
+		stat, ok := stats[c.PersonID]
+		if !ok {
+			stat = &dto.PersonStatResponse{PersonID: c.PersonID, Name: c.Edges.Person.Name}
+			stats[c.PersonID] = stat
+			seen[c.PersonID] = map[int]bool{}
+		}
+		if seen[c.PersonID][c.MovieID] {
+			continue
+		}
+		seen[c.PersonID][c.MovieID] = true
+
+		watches := len(c.Edges.Movie.Edges.WatchEvents)
+		stat.TitleCount++
+		stat.WatchCount += watches
+		if watches > 0 {
+			stat.WatchedCount++
+		}
+	}
+
+	result := make([]*dto.PersonStatResponse, 0, len(stats))
+	for _, stat := range stats {
+		result = append(result, stat)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		if a.WatchCount != b.WatchCount {
+			return a.WatchCount > b.WatchCount
+		}
+		if a.WatchedCount != b.WatchedCount {
+			return a.WatchedCount > b.WatchedCount
+		}
+		return a.Name < b.Name
+	})
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = defaultPersonStatsLimit
+	}
+	if len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
+// クレジット追加リクエストから人物IDを解決（名前指定で未登録の場合は作成する）
+func resolvePersonID(ctx context.Context, client *ent.Client, userID int, req *dto.CreditRequest) (int, error) {
+	if req.PersonID > 0 {
+		exists, err := client.Person.Query().
+			Where(person.IDEQ(req.PersonID), person.UserIDEQ(userID)).
+			Exist(ctx)
+		if err != nil {
+			return 0, errors.NewInternalServerError("人物の取得に失敗しました")
+		}
+		if !exists {
+			return 0, errors.NewNotFoundError("人物が見つかりません")
+		}
+		return req.PersonID, nil
+	}
+
+	name := strings.TrimSpace(req.PersonName)
+	if name == "" {
+		return 0, errors.NewBadRequestError("person_id か person_name を指定してください")
+	}
+
+	p, err := client.Person.Query().
+		Where(person.UserIDEQ(userID), person.NameEQ(name)).
+		Only(ctx)
+	if err == nil {
+		return p.ID, nil
+	}
+	if !ent.IsNotFound(err) {
+		return 0, errors.NewInternalServerError("人物の取得に失敗しました")
+	}
+
+	p, err = client.Person.Create().
+		SetUserID(userID).
+		SetName(name).
+		Save(ctx)
+	if err != nil {
+		return 0, errors.NewInternalServerError("人物の作成に失敗しました")
+	}
+	return p.ID, nil
+}
+
+// ゴミ箱に入っていない作品のクレジットのみ読み込む（担当作品数の算出用）
+func withActiveCredits(q *ent.CreditQuery) {
+	q.Where(credit.HasMovieWith(movie.DeletedAtIsNil())).
+		Select(credit.FieldPersonID, credit.FieldMovieID)
+}
+
+// 作品詳細用にクレジットを役割順で読み込む
+func withCreditPeople(q *ent.CreditQuery) {
+	q.WithPerson().
+		Order(ent.Asc(credit.FieldRole), ent.Asc(credit.FieldID))
+}
@@ -0,0 +1,238 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"watchlist-app/dto"
+	"watchlist-app/ent"
+	"watchlist-app/ent/availability"
+	"watchlist-app/ent/movie"
+	"watchlist-app/ent/platform"
+	"watchlist-app/ent/predicate"
+	"watchlist-app/pkg/errors"
+)
+
+const defaultLeavingDays = 7
+
+type PlatformService struct {
+	client *ent.Client
+}
+
+func NewPlatformService(client *ent.Client) *PlatformService {
+	return &PlatformService{
+		client: client,
+	}
+}
+
+// 配信サービス一覧取得
+func (s *PlatformService) GetPlatforms(ctx context.Context) ([]*ent.Platform, error) {
+	platforms, err := s.client.Platform.Query().
+		Order(ent.Asc(platform.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.NewInternalServerError("配信サービスの取得に失敗しました")
+	}
+	return platforms, nil
+}
+
+// 配信サービス追加（全ユーザー共通）
+func (s *PlatformService) CreatePlatform(ctx context.Context, req *dto.PlatformRequest) (*ent.Platform, error) {
+	p, err := s.client.Platform.Create().
+		SetSlug(strings.ToLower(strings.TrimSpace(req.Slug))).
+		SetName(strings.TrimSpace(req.Name)).
+		Save(ctx)
+	if err != nil {
+		if ent.IsValidationError(err) {
+			return nil, errors.NewBadRequestError("識別子は英小文字・数字・アンダースコアで入力してください")
+		}
+		if ent.IsConstraintError(err) {
+			return nil, errors.NewConflictError("同じ識別子の配信サービスが既に存在します")
+		}
+		return nil, errors.NewInternalServerError("配信サービスの作成に失敗しました")
+	}
+	return p, nil
+}
+
+// 作品の配信状況一覧取得
+func (s *PlatformService) GetAvailabilities(ctx context.Context, userID, movieID int) ([]*ent.Availability, error) {
+	if err := ensureMovieOwned(ctx, s.client, userID, movieID); err != nil {
+		return nil, err
+	}
+
+	availabilities, err := s.client.Availability.Query().
+		Where(availability.MovieIDEQ(movieID)).
+		WithPlatform().
+		All(ctx)
+	if err != nil {
+		return nil, errors.NewInternalServerError("配信状況の取得に失敗しました")
+	}
+	return availabilities, nil
+}
+
+// 作品の配信期間を登録・更新
+func (s *PlatformService) SetAvailability(ctx context.Context, userID, movieID int, slug string, req *dto.AvailabilityRequest) (*ent.Availability, error) {
+	if req.AvailableFrom != nil && req.AvailableUntil != nil && req.AvailableUntil.Before(*req.AvailableFrom) {
+		return nil, errors.NewBadRequestError("配信終了日は配信開始日以降の日付を指定してください")
+	}
+
+	var platformID int
+	err := withTx(ctx, s.client, func(tx *ent.Tx) error {
+		if err := ensureMovieOwned(ctx, tx.Client(), userID, movieID); err != nil {
+			return err
+		}
+
+		p, err := findPlatform(ctx, tx.Client(), slug)
+		if err != nil {
+			return err
+		}
+		platformID = p.ID
+
+		exists, err := tx.Availability.Query().
+			Where(availability.MovieIDEQ(movieID), availability.PlatformIDEQ(p.ID)).
+			Exist(ctx)
+		if err != nil {
+			return errors.NewInternalServerError("配信状況の取得に失敗しました")
+		}
+		if exists {
+			// 省略された日付は未設定に戻す
+			builder := tx.Availability.Update().
+				Where(availability.MovieIDEQ(movieID), availability.PlatformIDEQ(p.ID))
+			if req.AvailableFrom != nil {
+				builder = builder.SetAvailableFrom(*req.AvailableFrom)
+			} else {
+				builder = builder.ClearAvailableFrom()
+			}
+			if req.AvailableUntil != nil {
+				builder = builder.SetAvailableUntil(*req.AvailableUntil)
+			} else {
+				builder = builder.ClearAvailableUntil()
+			}
+			if err := builder.Exec(ctx); err != nil {
+				return errors.NewInternalServerError("配信状況の更新に失敗しました")
+			}
+			return nil
+		}
+
+		err = tx.Availability.Create().
+			SetMovieID(movieID).
+			SetPlatformID(p.ID).
+			SetNillableAvailableFrom(req.AvailableFrom).
+			SetNillableAvailableUntil(req.AvailableUntil).
+			Exec(ctx)
+		if err != nil {
+			return errors.NewInternalServerError("配信状況の登録に失敗しました")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := s.client.Availability.Query().
+		Where(availability.MovieIDEQ(movieID), availability.PlatformIDEQ(platformID)).
+		WithPlatform().
+		Only(ctx)
+	if err != nil {
+		return nil, errors.NewInternalServerError("配信状況の取得に失敗しました")
+	}
+	return a, nil
+}
+
+// 作品の配信状況を削除
+func (s *PlatformService) DeleteAvailability(ctx context.Context, userID, movieID int, slug string) error {
+	if err := ensureMovieOwned(ctx, s.client, userID, movieID); err != nil {
+		return err
+	}
+
+	p, err := findPlatform(ctx, s.client, slug)
+	if err != nil {
+		return err
+	}
+
+	n, err := s.client.Availability.Delete().
+		Where(availability.MovieIDEQ(movieID), availability.PlatformIDEQ(p.ID)).
+		Exec(ctx)
+	if err != nil {
+		return errors.NewInternalServerError("配信状況の削除に失敗しました")
+	}
+	if n == 0 {
+		return errors.NewNotFoundError("この作品の配信状況は登録されていません")
+	}
+	return nil
+}
+
+// 指定日数以内に配信終了する作品一覧（終了日の近い順）
+func (s *PlatformService) GetLeaving(ctx context.Context, userID int, slug string, filter *dto.LeavingFilter) ([]*ent.Availability, error) {
+	p, err := findPlatform(ctx, s.client, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	days := filter.Days
+	if days <= 0 {
+		days = defaultLeavingDays
+	}
+	now := time.Now()
+
+	moviePredicates := []predicate.Movie{movie.UserIDEQ(userID), movie.DeletedAtIsNil()}
+	if filter.Status != "" {
+		moviePredicates = append(moviePredicates, movie.WatchStatusEQ(movie.WatchStatus(filter.Status)))
+	}
+
+	availabilities, err := s.client.Availability.Query().
+		Where(
+			availability.PlatformIDEQ(p.ID),
+			availability.AvailableUntilGTE(now),
+			availability.AvailableUntilLTE(now.AddDate(0, 0, days)),
+			availability.HasMovieWith(moviePredicates...),
+		).
+		WithMovie(func(q *ent.MovieQuery) {
+			q.WithTags(withTagNames).
+				WithWatchEvents(withWatchEventIDs)
+		}).
+		Order(ent.Asc(availability.FieldAvailableUntil)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.NewInternalServerError("配信終了予定の作品の取得に失敗しました")
+	}
+	return availabilities, nil
+}
+
+// 指定日時に配信中の作品の条件（開始日・終了日が未設定の場合は期限なしとみなす）
+func availableAt(t time.Time) predicate.Availability {
+	return availability.And(
+		availability.Or(availability.AvailableFromIsNil(), availability.AvailableFromLTE(t)),
+		availability.Or(availability.AvailableUntilIsNil(), availability.AvailableUntilGTE(t)),
+	)
+}
+
+// 配信状況が指定日時に配信中かどうか
+func IsAvailableAt(a *ent.Availability, t time.Time) bool {
+	if a.AvailableFrom != nil && a.AvailableFrom.After(t) {
+		return false
+	}
+	if a.AvailableUntil != nil && a.AvailableUntil.Before(t) {
+		return false
+	}
+	return true
+}
+
+func findPlatform(ctx context.Context, client *ent.Client, slug string) (*ent.Platform, error) {
+	p, err := client.Platform.Query().
+		Where(platform.SlugEQ(strings.ToLower(slug))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.NewNotFoundError("配信サービスが見つかりません")
+		}
+		return nil, errors.NewInternalServerError("配信サービスの取得に失敗しました")
+	}
+	return p, nil
+}
+
+// 作品詳細用に配信状況を配信サービス付きで読み込む
+func withPlatforms(q *ent.AvailabilityQuery) {
+	q.WithPlatform()
+}
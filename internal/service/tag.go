@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"watchlist-app/dto"
+	"watchlist-app/ent"
+	"watchlist-app/ent/movie"
+	"watchlist-app/ent/tag"
+	"watchlist-app/pkg/errors"
+)
+
+type TagService struct {
+	client *ent.Client
+}
+
+func NewTagService(client *ent.Client) *TagService {
+	return &TagService{
+		client: client,
+	}
+}
+
+// タグ一覧取得（紐づく作品IDを含む）
+func (s *TagService) GetTags(ctx context.Context, userID int) ([]*ent.Tag, error) {
+	tags, err := s.client.Tag.Query().
+		Where(tag.UserIDEQ(userID)).
+		WithMovies(selectMovieID).
+		Order(ent.Asc(tag.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.NewInternalServerError("タグの取得に失敗しました")
+	}
+	return tags, nil
+}
+
+// タグ詳細取得（紐づく作品IDを含む）
+func (s *TagService) GetTag(ctx context.Context, userID, id int) (*ent.Tag, error) {
+	t, err := s.client.Tag.Query().
+		Where(tag.IDEQ(id), tag.UserIDEQ(userID)).
+		WithMovies(selectMovieID).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.NewNotFoundError("タグが見つかりません")
+		}
+		return nil, errors.NewInternalServerError("タグの取得に失敗しました")
+	}
+	return t, nil
+}
+
+// タグ作成
+func (s *TagService) CreateTag(ctx context.Context, userID int, req *dto.TagRequest) (*ent.Tag, error) {
+	name := normalizeTagName(req.Name)
+	if name == "" {
+		return nil, errors.NewBadRequestError("タグ名を入力してください")
+	}
+
+	t, err := s.client.Tag.Create().
+		SetUserID(userID).
+		SetName(name).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, errors.NewConflictError("同じ名前のタグが既に存在します")
+		}
+		return nil, errors.NewInternalServerError("タグの作成に失敗しました")
+	}
+	return t, nil
+}
+
+// タグ名変更
+func (s *TagService) UpdateTag(ctx context.Context, userID, id int, req *dto.TagRequest) (*ent.Tag, error) {
+	name := normalizeTagName(req.Name)
+	if name == "" {
+		return nil, errors.NewBadRequestError("タグ名を入力してください")
+	}
+
+	err := s.client.Tag.UpdateOneID(id).
+		Where(tag.UserIDEQ(userID)).
+		SetName(name).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.NewNotFoundError("タグが見つかりません")
+		}
+		if ent.IsConstraintError(err) {
+			return nil, errors.NewConflictError("同じ名前のタグが既に存在します")
+		}
+		return nil, errors.NewInternalServerError("タグの更新に失敗しました")
+	}
+	return s.GetTag(ctx, userID, id)
+}
+
+// タグ削除（作品との紐づけも解除される）
+func (s *TagService) DeleteTag(ctx context.Context, userID, id int) error {
+	err := s.client.Tag.DeleteOneID(id).Where(tag.UserIDEQ(userID)).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return errors.NewNotFoundError("タグが見つかりません")
+		}
+		return errors.NewInternalServerError("タグの削除に失敗しました")
+	}
+	return nil
+}
+
+// タグ名からタグIDを解決（未登録のタグは作成する）
+func resolveTagIDs(ctx context.Context, client *ent.Client, userID int, names []string) ([]int, error) {
+	seen := make(map[string]bool, len(names))
+	normalized := make([]string, 0, len(names))
+	for _, n := range names {
+		n = normalizeTagName(n)
+		if n == "" || seen[n] {
+			continue
+		}
+		seen[n] = true
+		normalized = append(normalized, n)
+	}
+	if len(normalized) == 0 {
+		return nil, nil
+	}
+
+	existing, err := client.Tag.Query().
+		Where(tag.UserIDEQ(userID), tag.NameIn(normalized...)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.NewInternalServerError("タグの取得に失敗しました")
+	}
+
+	ids := make([]int, 0, len(normalized))
+	found := make(map[string]bool, len(existing))
+	for _, t := range existing {
+		found[t.Name] = true
+		ids = append(ids, t.ID)
+	}
+
+	for _, n := range normalized {
+		if found[n] {
+			continue
+		}
+		t, err := client.Tag.Create().
+			SetUserID(userID).
+			SetName(n).
+			Save(ctx)
+		if err != nil {
+			if ent.IsValidationError(err) {
+				return nil, errors.NewBadRequestError("タグ名はカンマを含まない1〜50文字で指定してください")
+			}
+			return nil, errors.NewInternalServerError("タグの作成に失敗しました")
+		}
+		ids = append(ids, t.ID)
+	}
+	return ids, nil
+}
+
+func normalizeTagName(name string) string {
+	return strings.TrimSpace(name)
+}
+
+func selectMovieID(q *ent.MovieQuery) {
+	q.Select(movie.FieldID)
+}
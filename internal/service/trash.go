@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"watchlist-app/ent"
+	"watchlist-app/ent/movie"
+	"watchlist-app/ent/schema"
+	"watchlist-app/pkg/errors"
+)
+
+type TrashService struct {
+	client    *ent.Client
+	retention time.Duration
+}
+
+func NewTrashService(client *ent.Client, retention time.Duration) *TrashService {
+	return &TrashService{
+		client:    client,
+		retention: retention,
+	}
+}
+
+// ゴミ箱の作品一覧取得（削除日時の新しい順）
+func (s *TrashService) GetTrash(ctx context.Context, userID int) ([]*ent.Movie, error) {
+	movies, err := s.client.Movie.Query().
+		Where(movie.UserIDEQ(userID), movie.DeletedAtNotNil()).
+		Order(ent.Desc(movie.FieldDeletedAt)).
+		All(schema.SkipSoftDelete(ctx))
+	if err != nil {
+		return nil, errors.NewInternalServerError("ゴミ箱の取得に失敗しました")
+	}
+	return movies, nil
+}
+
+// ゴミ箱から作品を復元
+func (s *TrashService) Restore(ctx context.Context, userID, id int) error {
+	n, err := s.client.Movie.Update().
+		Where(movie.IDEQ(id), movie.UserIDEQ(userID), movie.DeletedAtNotNil()).
+		ClearDeletedAt().
+		Save(schema.SkipSoftDelete(ctx))
+	if err != nil {
+		return errors.NewInternalServerError("映画の復元に失敗しました")
+	}
+	if n == 0 {
+		return errors.NewNotFoundError("ゴミ箱に映画が見つかりません")
+	}
+	return nil
+}
+
+// ゴミ箱の作品を完全に削除
+func (s *TrashService) Purge(ctx context.Context, userID, id int) error {
+	n, err := s.client.Movie.Delete().
+		Where(movie.IDEQ(id), movie.UserIDEQ(userID), movie.DeletedAtNotNil()).
+		Exec(schema.SkipSoftDelete(ctx))
+	if err != nil {
+		return errors.NewInternalServerError("映画の完全削除に失敗しました")
+	}
+	if n == 0 {
+		return errors.NewNotFoundError("ゴミ箱に映画が見つかりません")
+	}
+	return nil
+}
+
+// 保持期間を過ぎたゴミ箱の作品を完全に削除し、削除件数を返す
+func (s *TrashService) PurgeExpired(ctx context.Context) (int, error) {
+	n, err := s.client.Movie.Delete().
+		Where(movie.DeletedAtLT(time.Now().Add(-s.retention))).
+		Exec(schema.SkipSoftDelete(ctx))
+	if err != nil {
+		return 0, errors.NewInternalServerError("ゴミ箱の整理に失敗しました")
+	}
+	return n, nil
+}
+
+// 一定間隔で PurgeExpired を実行する（ctx がキャンセルされるまでブロックする）
+func (s *TrashService) RunPurger(ctx context.Context, interval time.Duration, logf func(format string, args ...any)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if n, err := s.PurgeExpired(ctx); err != nil {
+			logf("Failed to purge trash: %v", err)
+		} else if n > 0 {
+			logf("Purged %d movies from trash", n)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+
+	"watchlist-app/ent"
+	"watchlist-app/pkg/errors"
+)
+
+// トランザクション内で fn を実行し、エラー時はロールバックする
+func withTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return errors.NewInternalServerError("トランザクションの開始に失敗しました")
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.NewInternalServerError("トランザクションのコミットに失敗しました")
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"watchlist-app/dto"
+	"watchlist-app/ent"
+	"watchlist-app/ent/user"
+	"watchlist-app/pkg/errors"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+type UserService struct {
+	client *ent.Client
+}
+
+func NewUserService(client *ent.Client) *UserService {
+	return &UserService{
+		client: client,
+	}
+}
+
+// ユーザー登録
+func (s *UserService) Register(ctx context.Context, req *dto.RegisterRequest) (*ent.User, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, errors.NewInternalServerError("ユーザーの登録に失敗しました")
+	}
+
+	builder := s.client.User.Create().
+		SetEmail(normalizeEmail(req.Email)).
+		SetPasswordHash(string(hash))
+
+	if req.Name != "" {
+		builder = builder.SetName(req.Name)
+	}
+
+	u, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, errors.NewConflictError("このメールアドレスは既に登録されています")
+		}
+		return nil, errors.NewInternalServerError("ユーザーの登録に失敗しました")
+	}
+	return u, nil
+}
+
+// メールアドレスとパスワードで認証
+func (s *UserService) Authenticate(ctx context.Context, email, password string) (*ent.User, error) {
+	u, err := s.client.User.Query().
+		Where(user.EmailEQ(normalizeEmail(email))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.NewUnauthorizedError("メールアドレスまたはパスワードが正しくありません")
+		}
+		return nil, errors.NewInternalServerError("ユーザーの取得に失敗しました")
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(password)); err != nil {
+		return nil, errors.NewUnauthorizedError("メールアドレスまたはパスワードが正しくありません")
+	}
+	return u, nil
+}
+
+// ユーザー取得
+func (s *UserService) GetUser(ctx context.Context, id int) (*ent.User, error) {
+	u, err := s.client.User.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.NewNotFoundError("ユーザーが見つかりません")
+		}
+		return nil, errors.NewInternalServerError("ユーザーの取得に失敗しました")
+	}
+	return u, nil
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
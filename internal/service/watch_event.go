@@ -0,0 +1,213 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	"watchlist-app/dto"
+	"watchlist-app/ent"
+	"watchlist-app/ent/movie"
+	"watchlist-app/ent/predicate"
+	"watchlist-app/ent/watchevent"
+	"watchlist-app/pkg/errors"
+)
+
+type WatchEventService struct {
+	client *ent.Client
+}
+
+func NewWatchEventService(client *ent.Client) *WatchEventService {
+	return &WatchEventService{
+		client: client,
+	}
+}
+
+// 視聴履歴取得（新しい順）
+func (s *WatchEventService) GetWatchEvents(ctx context.Context, userID, movieID int) ([]*ent.WatchEvent, error) {
+	if err := ensureMovieOwned(ctx, s.client, userID, movieID); err != nil {
+		return nil, err
+	}
+
+	events, err := s.client.WatchEvent.Query().
+		Where(watchevent.MovieIDEQ(movieID)).
+		Order(ent.Desc(watchevent.FieldWatchedAt), ent.Desc(watchevent.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.NewInternalServerError("視聴履歴の取得に失敗しました")
+	}
+	return events, nil
+}
+
+// 複数の作品の視聴履歴を、作品ごとに新しい順で limit 件まで1回のクエリで取得する
+// 作品の一覧から視聴履歴を参照する場合に、作品ごとに問い合わせないようにするため
+func (s *WatchEventService) GetLatestWatchEvents(ctx context.Context, userID int, movieIDs []int, limit int) (map[int][]*ent.WatchEvent, error) {
+	byMovie := make(map[int][]*ent.WatchEvent, len(movieIDs))
+	if len(movieIDs) == 0 || limit <= 0 {
+		return byMovie, nil
+	}
+
+	events, err := s.client.WatchEvent.Query().
+		Where(
+			watchevent.HasMovieWith(movie.UserIDEQ(userID)),
+			latestWatchEventsPerMovie(movieIDs, limit),
+		).
+		Order(ent.Desc(watchevent.FieldWatchedAt), ent.Desc(watchevent.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.NewInternalServerError("視聴履歴の取得に失敗しました")
+	}
+
+	for _, e := range events {
+		byMovie[e.MovieID] = append(byMovie[e.MovieID], e)
+	}
+	return byMovie, nil
+}
+
+// 作品ごとに新しい順で番号を付け（ROW_NUMBER）、limit 番目までの視聴記録に絞り込む
+func latestWatchEventsPerMovie(movieIDs []int, limit int) predicate.WatchEvent {
+	return func(s *sql.Selector) {
+		b := sql.Dialect(s.Dialect())
+		t := b.Table(watchevent.Table)
+		ranked := b.Select(t.C(watchevent.FieldID)).
+			AppendSelectExprAs(
+				sql.RowNumber().
+					PartitionBy(t.C(watchevent.FieldMovieID)).
+					OrderExpr(sql.Expr(t.C(watchevent.FieldWatchedAt)+" DESC, "+t.C(watchevent.FieldID)+" DESC")),
+				"rn",
+			).
+			From(t).
+			Where(sql.InInts(t.C(watchevent.FieldMovieID), movieIDs...)).
+			As("ranked")
+
+		s.Where(sql.In(
+			s.C(watchevent.FieldID),
+			b.Select(ranked.C(watchevent.FieldID)).
+				From(ranked).
+				Where(sql.LTE(ranked.C("rn"), limit)),
+		))
+	}
+}
+
+// 視聴記録追加（未完了の作品は視聴完了にする）
+func (s *WatchEventService) CreateWatchEvent(ctx context.Context, userID, movieID int, req *dto.CreateWatchEventRequest) (*ent.WatchEvent, error) {
+	var event *ent.WatchEvent
+	err := withTx(ctx, s.client, func(tx *ent.Tx) error {
+		client := tx.Client()
+
+		if err := ensureMovieOwned(ctx, client, userID, movieID); err != nil {
+			return err
+		}
+
+		builder := client.WatchEvent.Create().SetMovieID(movieID)
+		if req.WatchedAt != nil {
+			builder = builder.SetWatchedAt(*req.WatchedAt)
+		}
+		if req.Rating > 0 {
+			builder = builder.SetRating(req.Rating)
+		}
+		if req.Note != "" {
+			builder = builder.SetNote(req.Note)
+		}
+		if req.Location != "" {
+			builder = builder.SetLocation(req.Location)
+		}
+
+		var err error
+		event, err = builder.Save(ctx)
+		if err != nil {
+			return errors.NewInternalServerError("視聴記録の追加に失敗しました")
+		}
+
+		err = client.Movie.Update().
+			Where(movie.IDEQ(movieID), movie.WatchStatusNEQ(movie.WatchStatusCompleted)).
+			SetWatchStatus(movie.WatchStatusCompleted).
+			Exec(ctx)
+		if err != nil {
+			return errors.NewInternalServerError("視聴ステータスの更新に失敗しました")
+		}
+
+		return syncWatchedAt(ctx, client, movieID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// 視聴記録削除
+func (s *WatchEventService) DeleteWatchEvent(ctx context.Context, userID, movieID, eventID int) error {
+	return withTx(ctx, s.client, func(tx *ent.Tx) error {
+		client := tx.Client()
+
+		if err := ensureMovieOwned(ctx, client, userID, movieID); err != nil {
+			return err
+		}
+
+		err := client.WatchEvent.DeleteOneID(eventID).
+			Where(watchevent.MovieIDEQ(movieID)).
+			Exec(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return errors.NewNotFoundError("視聴記録が見つかりません")
+			}
+			return errors.NewInternalServerError("視聴記録の削除に失敗しました")
+		}
+
+		return syncWatchedAt(ctx, client, movieID)
+	})
+}
+
+// 視聴記録を追加し、作品の視聴完了日を同期する
+func recordWatch(ctx context.Context, client *ent.Client, movieID int, at time.Time) error {
+	err := client.WatchEvent.Create().
+		SetMovieID(movieID).
+		SetWatchedAt(at).
+		Exec(ctx)
+	if err != nil {
+		return errors.NewInternalServerError("視聴記録の追加に失敗しました")
+	}
+	return syncWatchedAt(ctx, client, movieID)
+}
+
+// 作品の視聴完了日を視聴履歴の最新日に合わせる（履歴がなければクリア）
+func syncWatchedAt(ctx context.Context, client *ent.Client, movieID int) error {
+	latest, err := client.WatchEvent.Query().
+		Where(watchevent.MovieIDEQ(movieID)).
+		Order(ent.Desc(watchevent.FieldWatchedAt)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return errors.NewInternalServerError("視聴履歴の取得に失敗しました")
+	}
+
+	builder := client.Movie.UpdateOneID(movieID)
+	if latest != nil {
+		builder = builder.SetWatchedAt(latest.WatchedAt)
+	} else {
+		builder = builder.ClearWatchedAt()
+	}
+	if err := builder.Exec(ctx); err != nil {
+		return errors.NewInternalServerError("視聴完了日の更新に失敗しました")
+	}
+	return nil
+}
+
+// 作品がユーザーの所有であることを確認
+func ensureMovieOwned(ctx context.Context, client *ent.Client, userID, movieID int) error {
+	exists, err := client.Movie.Query().
+		Where(movie.IDEQ(movieID), movie.UserIDEQ(userID)).
+		Exist(ctx)
+	if err != nil {
+		return errors.NewInternalServerError("映画の取得に失敗しました")
+	}
+	if !exists {
+		return errors.NewNotFoundError("映画が見つかりません")
+	}
+	return nil
+}
+
+// 視聴回数の算出用に視聴履歴のIDのみ読み込む
+func withWatchEventIDs(q *ent.WatchEventQuery) {
+	q.Select(watchevent.FieldID, watchevent.FieldMovieID)
+}
@@ -0,0 +1,373 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"watchlist-app/dto"
+	"watchlist-app/ent"
+	entwebhook "watchlist-app/ent/webhook"
+	"watchlist-app/ent/webhookdelivery"
+	"watchlist-app/pkg/errors"
+	"watchlist-app/pkg/webhook"
+)
+
+const (
+	// 1回の送信処理で扱う配信の件数
+	webhookDeliveryBatchSize = 50
+	// 配信履歴の既定の取得件数
+	defaultWebhookDeliveryLimit = 20
+	// 記録するエラーの最大文字数
+	maxWebhookErrorLength = 1000
+)
+
+type WebhookService struct {
+	client      *ent.Client
+	httpClient  *http.Client
+	maxAttempts int
+}
+
+func NewWebhookService(client *ent.Client, timeout time.Duration, maxAttempts int) *WebhookService {
+	return &WebhookService{
+		client:      client,
+		httpClient:  webhook.NewHTTPClient(timeout),
+		maxAttempts: maxAttempts,
+	}
+}
+
+// Webhook 一覧取得
+func (s *WebhookService) GetWebhooks(ctx context.Context, userID int) ([]*ent.Webhook, error) {
+	hooks, err := s.client.Webhook.Query().
+		Where(entwebhook.UserIDEQ(userID)).
+		Order(ent.Asc(entwebhook.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.NewInternalServerError("Webhook の取得に失敗しました")
+	}
+	return hooks, nil
+}
+
+// Webhook 詳細取得
+func (s *WebhookService) GetWebhook(ctx context.Context, userID, id int) (*ent.Webhook, error) {
+	h, err := s.client.Webhook.Query().
+		Where(entwebhook.IDEQ(id), entwebhook.UserIDEQ(userID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.NewNotFoundError("Webhook が見つかりません")
+		}
+		return nil, errors.NewInternalServerError("Webhook の取得に失敗しました")
+	}
+	return h, nil
+}
+
+// Webhook 作成（シークレットを省略した場合は生成する）
+func (s *WebhookService) CreateWebhook(ctx context.Context, userID int, req *dto.WebhookRequest) (*ent.Webhook, error) {
+	if err := checkWebhookURL(ctx, req.URL); err != nil {
+		return nil, err
+	}
+
+	secret := req.Secret
+	if secret == "" {
+		generated, err := webhook.NewSecret()
+		if err != nil {
+			return nil, errors.NewInternalServerError("シークレットの生成に失敗しました")
+		}
+		secret = generated
+	}
+
+	builder := s.client.Webhook.Create().
+		SetUserID(userID).
+		SetURL(req.URL).
+		SetSecret(secret).
+		SetEvents(normalizeWebhookEvents(req.Events))
+	if req.Active != nil {
+		builder = builder.SetActive(*req.Active)
+	}
+
+	h, err := builder.Save(ctx)
+	if err != nil {
+		return nil, errors.NewInternalServerError("Webhook の作成に失敗しました")
+	}
+	return h, nil
+}
+
+// Webhook 更新（シークレットを省略した場合は変更しない）
+func (s *WebhookService) UpdateWebhook(ctx context.Context, userID, id int, req *dto.WebhookRequest) (*ent.Webhook, error) {
+	if err := checkWebhookURL(ctx, req.URL); err != nil {
+		return nil, err
+	}
+
+	builder := s.client.Webhook.UpdateOneID(id).
+		Where(entwebhook.UserIDEQ(userID)).
+		SetURL(req.URL).
+		SetEvents(normalizeWebhookEvents(req.Events))
+	if req.Secret != "" {
+		builder = builder.SetSecret(req.Secret)
+	}
+	if req.Active != nil {
+		builder = builder.SetActive(*req.Active)
+	}
+
+	h, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.NewNotFoundError("Webhook が見つかりません")
+		}
+		return nil, errors.NewInternalServerError("Webhook の更新に失敗しました")
+	}
+	return h, nil
+}
+
+// Webhook 削除（配信履歴も削除される）
+func (s *WebhookService) DeleteWebhook(ctx context.Context, userID, id int) error {
+	err := s.client.Webhook.DeleteOneID(id).Where(entwebhook.UserIDEQ(userID)).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return errors.NewNotFoundError("Webhook が見つかりません")
+		}
+		return errors.NewInternalServerError("Webhook の削除に失敗しました")
+	}
+	return nil
+}
+
+// 配信履歴取得（新しい順）
+func (s *WebhookService) GetDeliveries(ctx context.Context, userID, webhookID int, filter *dto.WebhookDeliveryFilter) ([]*ent.WebhookDelivery, error) {
+	if _, err := s.GetWebhook(ctx, userID, webhookID); err != nil {
+		return nil, err
+	}
+
+	limit := filter.Limit
+	if limit == 0 {
+		limit = defaultWebhookDeliveryLimit
+	}
+
+	query := s.client.WebhookDelivery.Query().
+		Where(webhookdelivery.WebhookIDEQ(webhookID))
+	if filter.Status != "" {
+		query = query.Where(webhookdelivery.StatusEQ(webhookdelivery.Status(filter.Status)))
+	}
+
+	deliveries, err := query.
+		Order(ent.Desc(webhookdelivery.FieldCreatedAt), ent.Desc(webhookdelivery.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, errors.NewInternalServerError("配信履歴の取得に失敗しました")
+	}
+	return deliveries, nil
+}
+
+// 配信履歴詳細取得
+func (s *WebhookService) GetDelivery(ctx context.Context, userID, webhookID, id int) (*ent.WebhookDelivery, error) {
+	if _, err := s.GetWebhook(ctx, userID, webhookID); err != nil {
+		return nil, err
+	}
+
+	d, err := s.client.WebhookDelivery.Query().
+		Where(webhookdelivery.IDEQ(id), webhookdelivery.WebhookIDEQ(webhookID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.NewNotFoundError("配信履歴が見つかりません")
+		}
+		return nil, errors.NewInternalServerError("配信履歴の取得に失敗しました")
+	}
+	return d, nil
+}
+
+// 配信を同じ本文で再送する（新しい配信として記録し、次の送信処理で送る）
+func (s *WebhookService) Redeliver(ctx context.Context, userID, webhookID, id int) (*ent.WebhookDelivery, error) {
+	original, err := s.GetDelivery(ctx, userID, webhookID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := s.client.WebhookDelivery.Create().
+		SetWebhookID(original.WebhookID).
+		SetEvent(original.Event).
+		SetMovieID(original.MovieID).
+		SetPayload(original.Payload).
+		SetNextAttemptAt(time.Now()).
+		SetRedeliveryOf(original.ID).
+		Save(ctx)
+	if err != nil {
+		return nil, errors.NewInternalServerError("再送の登録に失敗しました")
+	}
+	return d, nil
+}
+
+// 送信予定日時を過ぎた配信を送信し、処理した件数を返す
+// 無効な Webhook の配信は有効に戻るまで送信しない
+func (s *WebhookService) DeliverPending(ctx context.Context) (int, error) {
+	now := time.Now()
+	deliveries, err := s.client.WebhookDelivery.Query().
+		Where(
+			webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+			webhookdelivery.NextAttemptAtLTE(now),
+			webhookdelivery.HasWebhookWith(entwebhook.Active(true)),
+		).
+		WithWebhook().
+		Order(ent.Asc(webhookdelivery.FieldNextAttemptAt), ent.Asc(webhookdelivery.FieldID)).
+		Limit(webhookDeliveryBatchSize).
+		All(ctx)
+	if err != nil {
+		return 0, errors.NewInternalServerError("配信の取得に失敗しました")
+	}
+
+	processed := 0
+	for _, d := range deliveries {
+		// 複数のサーバーで同時に送信しないよう、送信予定日時を先に進めてから送る
+		claimed, err := s.client.WebhookDelivery.Update().
+			Where(
+				webhookdelivery.IDEQ(d.ID),
+				webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+				webhookdelivery.NextAttemptAtEQ(*d.NextAttemptAt),
+			).
+			SetNextAttemptAt(now.Add(s.httpClient.Timeout + time.Minute)).
+			Save(ctx)
+		if err != nil {
+			return processed, errors.NewInternalServerError("配信の更新に失敗しました")
+		}
+		if claimed == 0 {
+			continue
+		}
+
+		if err := s.deliver(ctx, d); err != nil {
+			return processed, err
+		}
+		processed++
+	}
+	return processed, nil
+}
+
+// 1件の配信を送信して結果を記録する
+// 失敗した場合は試行回数に応じて間隔を空けて再送し、上限に達したら failed とする
+func (s *WebhookService) deliver(ctx context.Context, d *ent.WebhookDelivery) error {
+	h := d.Edges.Webhook
+	attempts := d.Attempts + 1
+	statusCode, sendErr := s.send(ctx, h, d)
+
+	update := s.client.WebhookDelivery.UpdateOneID(d.ID).
+		SetAttempts(attempts)
+	if statusCode > 0 {
+		update = update.SetResponseStatus(statusCode)
+	} else {
+		update = update.ClearResponseStatus()
+	}
+
+	switch {
+	case sendErr == nil:
+		update = update.
+			SetStatus(webhookdelivery.StatusSucceeded).
+			SetDeliveredAt(time.Now()).
+			ClearNextAttemptAt().
+			ClearLastError()
+	case attempts >= s.maxAttempts:
+		update = update.
+			SetStatus(webhookdelivery.StatusFailed).
+			ClearNextAttemptAt().
+			SetLastError(truncateRunes(sendErr.Error(), maxWebhookErrorLength))
+	default:
+		update = update.
+			SetNextAttemptAt(time.Now().Add(webhook.Backoff(attempts))).
+			SetLastError(truncateRunes(sendErr.Error(), maxWebhookErrorLength))
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		return errors.NewInternalServerError("配信結果の記録に失敗しました")
+	}
+	return nil
+}
+
+// 署名付きで POST し、レスポンスのステータスを返す（2xx 以外はエラー）
+// 配信先のレスポンス本文は配信履歴から参照できてしまうため記録しない
+func (s *WebhookService) send(ctx context.Context, h *ent.Webhook, d *ent.WebhookDelivery) (int, error) {
+	body := []byte(d.Payload)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "watchlist-app-webhook")
+	req.Header.Set(webhook.HeaderEvent, d.Event)
+	req.Header.Set(webhook.HeaderDelivery, strconv.Itoa(d.ID))
+	req.Header.Set(webhook.HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(webhook.HeaderSignature, webhook.Sign(h.Secret, timestamp, body))
+
+	res, err := s.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 1<<20))
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return res.StatusCode, fmt.Errorf("HTTP %d", res.StatusCode)
+	}
+	return res.StatusCode, nil
+}
+
+// 一定間隔で DeliverPending を実行する（ctx がキャンセルされるまでブロックする）
+func (s *WebhookService) RunDispatcher(ctx context.Context, interval time.Duration, logf func(format string, args ...any)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		// 1回で処理しきれなかった分は続けて送信する
+		for {
+			n, err := s.DeliverPending(ctx)
+			if err != nil {
+				logf("Failed to deliver webhooks: %v", err)
+				break
+			}
+			if n < webhookDeliveryBatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// 配信先の URL が内部のアドレスを指していないか確認する
+func checkWebhookURL(ctx context.Context, rawURL string) error {
+	if err := webhook.CheckURL(ctx, rawURL); err != nil {
+		if err == webhook.ErrDisallowedAddress {
+			return errors.NewBadRequestError("内部ネットワークのアドレスには配信できません")
+		}
+		return errors.NewBadRequestError("URL のホストを解決できません")
+	}
+	return nil
+}
+
+// 購読するイベントを重複なく、定義順に並べる
+func normalizeWebhookEvents(events []string) []string {
+	normalized := make([]string, 0, len(events))
+	for _, e := range webhook.Events {
+		if slices.Contains(events, e) {
+			normalized = append(normalized, e)
+		}
+	}
+	return normalized
+}
+
+func truncateRunes(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n]) + "…"
+}
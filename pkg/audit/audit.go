@@ -0,0 +1,10 @@
+package audit
+
+// 1フィールド分の変更内容（作成時の Old、削除時の New は nil）
+type FieldChange struct {
+	Old any `json:"old"`
+	New any `json:"new"`
+}
+
+// フィールド名 → 変更内容
+type Changes map[string]FieldChange
@@ -0,0 +1,18 @@
+package auth
+
+import "context"
+
+type contextKey struct{}
+
+var userIDKey = contextKey{}
+
+// 認証済みユーザーIDをコンテキストに設定
+func WithUserID(ctx context.Context, userID int) context.Context {
+	return context.WithValue(ctx, userIDKey, userID)
+}
+
+// コンテキストから認証済みユーザーIDを取得
+func UserIDFromContext(ctx context.Context) (int, bool) {
+	userID, ok := ctx.Value(userIDKey).(int)
+	return userID, ok
+}
@@ -1,24 +1,58 @@
 package config
 
 import (
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 )
 
+// config.yml に記載している開発用の署名鍵（本番環境では使えない）
+const devJWTSecret = "dev-secret-change-me"
+
+// 本番環境で必要な署名鍵の長さ（HS256 の出力と同じ 32 バイト以上）
+const minJWTSecretLength = 32
+
 type Config struct {
 	Server   ServerConfig
 	Database DatabaseConfig
 	App      AppConfig
+	Auth     AuthConfig
+	Trash    TrashConfig
+	Webhook  WebhookConfig
 }
 
 type AppConfig struct {
 	Environment string
 }
 
+type AuthConfig struct {
+	JWTSecret       string        `mapstructure:"jwt_secret"`
+	AccessTokenTTL  time.Duration `mapstructure:"access_token_ttl"`
+	RefreshTokenTTL time.Duration `mapstructure:"refresh_token_ttl"`
+}
+
+type TrashConfig struct {
+	// ゴミ箱に入れてから完全削除するまでの保持期間
+	Retention     time.Duration
+	PurgeInterval time.Duration `mapstructure:"purge_interval"`
+}
+
+type WebhookConfig struct {
+	// 配信1回あたりのタイムアウト
+	Timeout time.Duration
+	// 失敗とするまでの最大試行回数
+	MaxAttempts int `mapstructure:"max_attempts"`
+	// 送信待ちの配信を確認する間隔
+	DeliveryInterval time.Duration `mapstructure:"delivery_interval"`
+}
+
 type ServerConfig struct {
 	Host string
 	Port string
+	// gRPC サーバーのポート（REST とは別）
+	GRPCPort string `mapstructure:"grpc_port"`
 }
 
 type DatabaseConfig struct {
@@ -44,16 +78,26 @@ func Load() (*Config, error) {
 	_ = viper.BindEnv("app.environment")
 	_ = viper.BindEnv("server.host")
 	_ = viper.BindEnv("server.port")
+	_ = viper.BindEnv("server.grpc_port")
 	_ = viper.BindEnv("database.user")
 	_ = viper.BindEnv("database.password")
 	_ = viper.BindEnv("database.name")
 	_ = viper.BindEnv("database.host")
 	_ = viper.BindEnv("database.port")
+	_ = viper.BindEnv("auth.jwt_secret")
 
 	// セーフデフォルト
 	viper.SetDefault("app.environment", "development")
 	viper.SetDefault("server.host", "localhost")
 	viper.SetDefault("server.port", "8080")
+	viper.SetDefault("server.grpc_port", "9090")
+	viper.SetDefault("auth.access_token_ttl", "15m")
+	viper.SetDefault("auth.refresh_token_ttl", "720h")
+	viper.SetDefault("trash.retention", "720h")
+	viper.SetDefault("trash.purge_interval", "1h")
+	viper.SetDefault("webhook.timeout", "10s")
+	viper.SetDefault("webhook.max_attempts", 8)
+	viper.SetDefault("webhook.delivery_interval", "10s")
 
 	if err := viper.ReadInConfig(); err != nil {
 		if _, ok := err.(viper.ConfigFileNotFoundError); ok {
@@ -68,5 +112,35 @@ func Load() (*Config, error) {
 		return nil, err
 	}
 
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+
 	return &cfg, nil
 }
+
+// 定期処理の間隔など、不正な値のまま起動すると実行時に失敗する設定を確認する
+func (c *Config) validate() error {
+	// 公開されている既定の鍵や短い鍵ではトークンを偽造できるため、本番環境では起動しない
+	if c.App.Environment == "production" {
+		if c.Auth.JWTSecret == devJWTSecret {
+			return fmt.Errorf("auth.jwt_secret must be overridden with APP_AUTH_JWT_SECRET in production")
+		}
+		if len(c.Auth.JWTSecret) < minJWTSecretLength {
+			return fmt.Errorf("auth.jwt_secret must be at least %d bytes in production", minJWTSecretLength)
+		}
+	}
+	if c.Trash.PurgeInterval <= 0 {
+		return fmt.Errorf("trash.purge_interval must be positive: %s", c.Trash.PurgeInterval)
+	}
+	if c.Webhook.Timeout <= 0 {
+		return fmt.Errorf("webhook.timeout must be positive: %s", c.Webhook.Timeout)
+	}
+	if c.Webhook.MaxAttempts <= 0 {
+		return fmt.Errorf("webhook.max_attempts must be positive: %d", c.Webhook.MaxAttempts)
+	}
+	if c.Webhook.DeliveryInterval <= 0 {
+		return fmt.Errorf("webhook.delivery_interval must be positive: %s", c.Webhook.DeliveryInterval)
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package config
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func validConfig(environment, secret string) *Config {
+	return &Config{
+		App:     AppConfig{Environment: environment},
+		Auth:    AuthConfig{JWTSecret: secret},
+		Trash:   TrashConfig{PurgeInterval: time.Hour},
+		Webhook: WebhookConfig{Timeout: 10 * time.Second, MaxAttempts: 8, DeliveryInterval: 10 * time.Second},
+	}
+}
+
+func TestValidateJWTSecret(t *testing.T) {
+	long := strings.Repeat("k", minJWTSecretLength)
+	tests := []struct {
+		environment, secret string
+		wantErr             bool
+	}{
+		{"development", devJWTSecret, false},
+		{"development", "short", false},
+		{"production", devJWTSecret, true},
+		{"production", long[1:], true},
+		{"production", long, false},
+	}
+	for _, tt := range tests {
+		err := validConfig(tt.environment, tt.secret).validate()
+		if (err != nil) != tt.wantErr {
+			t.Errorf("validate(%s, %d bytes) = %v, wantErr %v", tt.environment, len(tt.secret), err, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,345 @@
+package csvimport
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// 1ファイルから取り込む最大行数
+const MaxRows = 5000
+
+// 作品に付けられるタグの数とタグ名の文字数の上限（作品の登録と同じ。ジャンルもタグとして登録する）
+const (
+	MaxTags          = 20
+	MaxTagNameLength = 50
+)
+
+// 対応するエクスポート元（watchlist はこのアプリのエクスポート形式）
+const (
+	SourceWatchlist  = "watchlist"
+	SourceLetterboxd = "letterboxd"
+	SourceFilmarks   = "filmarks"
+	SourceIMDb       = "imdb"
+)
+
+// watchlist 形式の列（エクスポートはこの順に出力する）
+var WatchlistHeader = []string{
+	"title", "release_year", "media_type", "watch_status", "rating", "watched_at",
+	"genre", "tags", "description", "review", "poster_url",
+}
+
+// Letterboxd の取り込み形式の列（エクスポートはこの順に出力する）
+var LetterboxdHeader = []string{"Title", "Year", "Rating", "WatchedDate", "Tags", "Review"}
+
+var ErrUnknownSource = errors.New("unknown source")
+
+// 取り込み対象の1行（Rating は 1〜5 に換算済み、未評価は 0）
+type Row struct {
+	// ヘッダーを1行目とした行番号
+	Line        int
+	Title       string
+	ReleaseYear int
+	Rating      int
+	WatchedAt   time.Time
+	Review      string
+	// movie・tv_series など。判別できない場合は空
+	MediaType string
+	// watchlist 形式のみ（他の形式では空）
+	WatchStatus string
+	Genre       string
+	Tags        []string
+	Description string
+	PosterURL   string
+	// 行の値を解釈できなかった場合のエラー
+	Err string
+}
+
+// エクスポート元ごとの列名（小文字、先に見つかった列を使う）と評価の換算方法
+type format struct {
+	title, year, rating, watched, review, mediaType, genre, tags []string
+	watchStatus, description, posterURL                          []string
+	// 元の評価値を 1〜5 に換算する
+	scale func(float64) int
+	// 元のメディアタイプの値を変換する（変換できない場合は false）
+	mediaTypes func(string) (string, bool)
+}
+
+var formats = map[string]format{
+	SourceWatchlist: {
+		title:       []string{"title"},
+		year:        []string{"release_year"},
+		rating:      []string{"rating"},
+		watched:     []string{"watched_at"},
+		review:      []string{"review"},
+		mediaType:   []string{"media_type"},
+		watchStatus: []string{"watch_status"},
+		genre:       []string{"genre"},
+		tags:        []string{"tags"},
+		description: []string{"description"},
+		posterURL:   []string{"poster_url"},
+		scale:       roundStars,
+		mediaTypes:  oneOf(mediaTypes),
+	},
+	// diary.csv・ratings.csv・reviews.csv・watched.csv と、Letterboxd の取り込み形式（評価は 0.5〜5 の星）
+	SourceLetterboxd: {
+		title:   []string{"name", "title"},
+		year:    []string{"year"},
+		rating:  []string{"rating"},
+		watched: []string{"watched date", "watcheddate", "date"},
+		review:  []string{"review"},
+		tags:    []string{"tags"},
+		scale:   roundStars,
+	},
+	// ratings.csv・watchlist.csv（評価は 1〜10）
+	SourceIMDb: {
+		title:     []string{"title"},
+		year:      []string{"year"},
+		rating:    []string{"your rating"},
+		watched:   []string{"date rated"},
+		mediaType: []string{"title type"},
+		genre:     []string{"genres"},
+		scale:     func(v float64) int { return clampRating(int(math.Ceil(v / 2))) },
+		// 不明な種類（ビデオゲームなど）は映画として扱う
+		mediaTypes: func(v string) (string, bool) {
+			if mt, ok := imdbMediaTypes[strings.ReplaceAll(v, " ", "")]; ok {
+				return mt, true
+			}
+			return "movie", true
+		},
+	},
+	// Filmarks にはエクスポート機能がないため、一般的な変換ツールの列名に合わせる（スコアは 0.1〜5.0）
+	SourceFilmarks: {
+		title:   []string{"タイトル", "作品名", "title"},
+		year:    []string{"製作年", "公開年", "year"},
+		rating:  []string{"スコア", "score", "rating"},
+		watched: []string{"鑑賞日", "watched date", "date"},
+		review:  []string{"レビュー", "感想", "review"},
+		scale:   roundStars,
+	},
+}
+
+// watchlist 形式で使える値
+var (
+	mediaTypes    = []string{"movie", "tv_series", "documentary", "anime"}
+	watchStatuses = []string{"want_to_watch", "watching", "completed", "dropped"}
+)
+
+// 日付の形式（エクスポート元によって異なる）
+var dateLayouts = []string{
+	time.RFC3339,
+	"2006-01-02",
+	"2006/01/02",
+	"2006/1/2",
+	"2006年1月2日",
+}
+
+// IMDb の Title Type → メディアタイプ
+var imdbMediaTypes = map[string]string{
+	"movie":        "movie",
+	"tvmovie":      "movie",
+	"video":        "movie",
+	"short":        "movie",
+	"tvshort":      "movie",
+	"tvseries":     "tv_series",
+	"tvminiseries": "tv_series",
+	"tvspecial":    "tv_series",
+}
+
+// CSV を読み込み、各行を作品の項目に変換する
+// ヘッダーにタイトル列がない場合や行数が多すぎる場合はエラー、行ごとの値の誤りは Row.Err に設定する
+func Parse(r io.Reader, source string) ([]*Row, error) {
+	f, ok := formats[source]
+	if !ok {
+		return nil, ErrUnknownSource
+	}
+
+	reader := csv.NewReader(skipBOM(r))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if err == io.EOF {
+			return nil, errors.New("CSV が空です")
+		}
+		return nil, fmt.Errorf("CSV を読み込めません: %w", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if _, ok := columns[name]; !ok {
+			columns[name] = i
+		}
+	}
+	col := func(names []string) int {
+		for _, n := range names {
+			if i, ok := columns[n]; ok {
+				return i
+			}
+		}
+		return -1
+	}
+	titleCol := col(f.title)
+	if titleCol < 0 {
+		return nil, fmt.Errorf("タイトルの列（%s）が見つかりません", strings.Join(f.title, "・"))
+	}
+	yearCol, ratingCol, watchedCol := col(f.year), col(f.rating), col(f.watched)
+	reviewCol, mediaTypeCol, genreCol, tagsCol := col(f.review), col(f.mediaType), col(f.genre), col(f.tags)
+	watchStatusCol, descriptionCol, posterURLCol := col(f.watchStatus), col(f.description), col(f.posterURL)
+
+	var rows []*Row
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%d行目を読み込めません: %w", line, err)
+		}
+		if len(rows) >= MaxRows {
+			return nil, fmt.Errorf("取り込めるのは %d 行までです", MaxRows)
+		}
+		value := func(i int) string {
+			if i < 0 || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		row := &Row{
+			Line:        line,
+			Title:       value(titleCol),
+			Review:      value(reviewCol),
+			Description: value(descriptionCol),
+			PosterURL:   value(posterURLCol),
+		}
+		if row.Title == "" {
+			if blank(record) {
+				continue
+			}
+			row.Err = "タイトルがありません"
+		}
+		if v := value(yearCol); v != "" {
+			year, err := strconv.Atoi(v)
+			if err != nil || year <= 0 {
+				row.Err = fmt.Sprintf("公開年 %q を解釈できません", v)
+			} else {
+				row.ReleaseYear = year
+			}
+		}
+		if v := value(ratingCol); v != "" && v != "-" {
+			score, err := strconv.ParseFloat(v, 64)
+			if err != nil || score <= 0 {
+				row.Err = fmt.Sprintf("評価 %q を解釈できません", v)
+			} else {
+				row.Rating = f.scale(score)
+			}
+		}
+		if v := value(watchedCol); v != "" {
+			if t, ok := parseDate(v); ok {
+				row.WatchedAt = t
+			} else {
+				row.Err = fmt.Sprintf("日付 %q を解釈できません", v)
+			}
+		}
+		if v := strings.ToLower(value(mediaTypeCol)); v != "" {
+			if mt, ok := f.mediaTypes(v); ok {
+				row.MediaType = mt
+			} else {
+				row.Err = fmt.Sprintf("メディアタイプ %q を解釈できません", v)
+			}
+		}
+		if v := strings.ToLower(value(watchStatusCol)); v != "" {
+			if ws, ok := oneOf(watchStatuses)(v); ok {
+				row.WatchStatus = ws
+			} else {
+				row.Err = fmt.Sprintf("視聴ステータス %q を解釈できません", v)
+			}
+		}
+		if v := value(genreCol); v != "" {
+			row.Genre, _, _ = strings.Cut(v, ",")
+			row.Genre = strings.TrimSpace(row.Genre)
+			if utf8.RuneCountInString(row.Genre) > MaxTagNameLength {
+				row.Err = fmt.Sprintf("ジャンル %q は %d 文字以内にしてください", row.Genre, MaxTagNameLength)
+			}
+		}
+		if v := value(tagsCol); v != "" {
+			for _, t := range strings.Split(v, ",") {
+				if t = strings.TrimSpace(t); t != "" {
+					row.Tags = append(row.Tags, t)
+				}
+			}
+			if err := checkTags(row.Tags); err != "" {
+				row.Err = err
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// タグの数と文字数を確認する（問題がなければ空文字列）
+func checkTags(tags []string) string {
+	if len(tags) > MaxTags {
+		return fmt.Sprintf("タグは %d 個までです（%d 個）", MaxTags, len(tags))
+	}
+	for _, t := range tags {
+		if utf8.RuneCountInString(t) > MaxTagNameLength {
+			return fmt.Sprintf("タグ %q は %d 文字以内にしてください", t, MaxTagNameLength)
+		}
+	}
+	return ""
+}
+
+// 0.5〜5 の星・スコアを四捨五入して 1〜5 にする
+func roundStars(v float64) int {
+	return clampRating(int(math.Round(v)))
+}
+
+// 値がいずれかに一致する場合のみそのまま使う
+func oneOf(values []string) func(string) (string, bool) {
+	return func(v string) (string, bool) {
+		return v, slices.Contains(values, v)
+	}
+}
+
+func clampRating(v int) int {
+	return min(max(v, 1), 5)
+}
+
+// 日付を解釈する（サーバーのタイムゾーンの0時として扱う）
+func parseDate(v string) (time.Time, bool) {
+	for _, layout := range dateLayouts {
+		if t, err := time.ParseInLocation(layout, v, time.Local); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func blank(record []string) bool {
+	for _, v := range record {
+		if strings.TrimSpace(v) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// Excel などが付ける UTF-8 の BOM を読み飛ばす
+func skipBOM(r io.Reader) io.Reader {
+	br := bufio.NewReader(r)
+	if b, err := br.Peek(3); err == nil && string(b) == "\xef\xbb\xbf" {
+		_, _ = br.Discard(3)
+	}
+	return br
+}
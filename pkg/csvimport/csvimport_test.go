@@ -0,0 +1,187 @@
+package csvimport
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func parseOne(t *testing.T, source, csv string) *Row {
+	t.Helper()
+	rows, err := Parse(strings.NewReader(csv), source)
+	if err != nil {
+		t.Fatalf("Parse error: %v", err)
+	}
+	if len(rows) != 1 {
+		t.Fatalf("got %d rows, want 1", len(rows))
+	}
+	return rows[0]
+}
+
+// エクスポート元ごとの評価を 1〜5 に換算する
+func TestParseRatingScale(t *testing.T) {
+	tests := []struct {
+		source, header, rating string
+		want                   int
+	}{
+		// Letterboxd は 0.5〜5 の星を四捨五入
+		{SourceLetterboxd, "Name,Rating", "0.5", 1},
+		{SourceLetterboxd, "Name,Rating", "1.5", 2},
+		{SourceLetterboxd, "Name,Rating", "2.5", 3},
+		{SourceLetterboxd, "Name,Rating", "3", 3},
+		{SourceLetterboxd, "Name,Rating", "4.5", 5},
+		{SourceLetterboxd, "Name,Rating", "5", 5},
+		// Filmarks は 0.1〜5.0 のスコアを四捨五入（1 未満は 1）
+		{SourceFilmarks, "タイトル,スコア", "0.1", 1},
+		{SourceFilmarks, "タイトル,スコア", "3.4", 3},
+		{SourceFilmarks, "タイトル,スコア", "3.5", 4},
+		{SourceFilmarks, "タイトル,スコア", "4.9", 5},
+		// IMDb は 1〜10 を 2 で割って切り上げ
+		{SourceIMDb, "Title,Your Rating", "1", 1},
+		{SourceIMDb, "Title,Your Rating", "2", 1},
+		{SourceIMDb, "Title,Your Rating", "3", 2},
+		{SourceIMDb, "Title,Your Rating", "6", 3},
+		{SourceIMDb, "Title,Your Rating", "7", 4},
+		{SourceIMDb, "Title,Your Rating", "9", 5},
+		{SourceIMDb, "Title,Your Rating", "10", 5},
+		// このアプリの形式はそのまま（範囲外は丸める）
+		{SourceWatchlist, "title,rating", "4", 4},
+		{SourceWatchlist, "title,rating", "7", 5},
+		// 未評価
+		{SourceLetterboxd, "Name,Rating", "", 0},
+		{SourceIMDb, "Title,Your Rating", "-", 0},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/%s", tt.source, tt.rating), func(t *testing.T) {
+			row := parseOne(t, tt.source, tt.header+"\nMovie,"+tt.rating+"\n")
+			if row.Err != "" {
+				t.Fatalf("Err = %q", row.Err)
+			}
+			if row.Rating != tt.want {
+				t.Errorf("Rating = %d, want %d", row.Rating, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDates(t *testing.T) {
+	want := time.Date(2024, 1, 31, 0, 0, 0, 0, time.Local)
+	for _, v := range []string{"2024-01-31", "2024/01/31", "2024/1/31", "2024年1月31日"} {
+		t.Run(v, func(t *testing.T) {
+			row := parseOne(t, SourceFilmarks, "作品名,鑑賞日\nMovie,"+v+"\n")
+			if row.Err != "" {
+				t.Fatalf("Err = %q", row.Err)
+			}
+			if !row.WatchedAt.Equal(want) {
+				t.Errorf("WatchedAt = %v, want %v", row.WatchedAt, want)
+			}
+		})
+	}
+
+	row := parseOne(t, SourceWatchlist, "title,watched_at\nMovie,2024-01-31T21:30:00+09:00\n")
+	if want := time.Date(2024, 1, 31, 12, 30, 0, 0, time.UTC); !row.WatchedAt.Equal(want) {
+		t.Errorf("WatchedAt = %v, want %v", row.WatchedAt, want)
+	}
+}
+
+func TestParseIMDb(t *testing.T) {
+	csv := "Const,Your Rating,Date Rated,Title,Title Type,Year,Genres\n" +
+		"tt0133093,9,2024-01-31,The Matrix,movie,1999,\"Action, Sci-Fi\"\n" +
+		"tt0903747,10,2024-02-01,Breaking Bad,TV Series,2008,\"Crime, Drama\"\n" +
+		"tt1234567,,,Some Game,Video Game,2020,\n"
+	rows, err := Parse(strings.NewReader(csv), SourceIMDb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []struct {
+		title, mediaType, genre string
+		year                    int
+	}{
+		{"The Matrix", "movie", "Action", 1999},
+		{"Breaking Bad", "tv_series", "Crime", 2008},
+		// 不明な種類は映画として扱う
+		{"Some Game", "movie", "", 2020},
+	}
+	if len(rows) != len(want) {
+		t.Fatalf("got %d rows, want %d", len(rows), len(want))
+	}
+	for i, w := range want {
+		r := rows[i]
+		if r.Err != "" || r.Title != w.title || r.MediaType != w.mediaType || r.Genre != w.genre || r.ReleaseYear != w.year {
+			t.Errorf("row %d = %+v, want %+v", i, r, w)
+		}
+		if r.Line != i+2 {
+			t.Errorf("row %d Line = %d, want %d", i, r.Line, i+2)
+		}
+	}
+}
+
+func TestParseRowErrors(t *testing.T) {
+	tests := []struct {
+		name, source, csv, want string
+	}{
+		{"タイトルなし", SourceLetterboxd, "Name,Year\n,2020\n", "タイトルがありません"},
+		{"公開年", SourceLetterboxd, "Name,Year\nMovie,unknown\n", `公開年 "unknown" を解釈できません`},
+		{"評価", SourceLetterboxd, "Name,Rating\nMovie,great\n", `評価 "great" を解釈できません`},
+		{"評価0", SourceLetterboxd, "Name,Rating\nMovie,0\n", `評価 "0" を解釈できません`},
+		{"日付", SourceLetterboxd, "Name,Date\nMovie,31-01-2024\n", `日付 "31-01-2024" を解釈できません`},
+		{"メディアタイプ", SourceWatchlist, "title,media_type\nMovie,film\n", `メディアタイプ "film" を解釈できません`},
+		{"視聴ステータス", SourceWatchlist, "title,watch_status\nMovie,done\n", `視聴ステータス "done" を解釈できません`},
+		// タグ・ジャンルは作品の登録と同じ上限
+		{"タグ名の文字数", SourceLetterboxd, "Name,Tags\nMovie,\"ok, " + strings.Repeat("長", MaxTagNameLength+1) + "\"\n", "文字以内にしてください"},
+		{"タグの数", SourceWatchlist, "title,tags\nMovie,\"" + strings.Repeat("t,", MaxTags) + "extra\"\n", "タグは 20 個までです（21 個）"},
+		{"ジャンルの文字数", SourceIMDb, "Title,Genres\nMovie,\"" + strings.Repeat("g", MaxTagNameLength+1) + ", Drama\"\n", "ジャンル"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			row := parseOne(t, tt.source, tt.csv)
+			if !strings.Contains(row.Err, tt.want) {
+				t.Errorf("Err = %q, want it to contain %q", row.Err, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseTagsWithinLimits(t *testing.T) {
+	tags := make([]string, MaxTags)
+	for i := range tags {
+		tags[i] = fmt.Sprintf("%s%d", strings.Repeat("長", MaxTagNameLength-2), i+10)
+	}
+	row := parseOne(t, SourceWatchlist, "title,tags\nMovie,\""+strings.Join(tags, ", ")+"\"\n")
+	if row.Err != "" {
+		t.Fatalf("Err = %q", row.Err)
+	}
+	if len(row.Tags) != MaxTags {
+		t.Errorf("got %d tags, want %d", len(row.Tags), MaxTags)
+	}
+}
+
+func TestParseFileErrors(t *testing.T) {
+	tests := []struct {
+		name, source, csv string
+	}{
+		{"不明なエクスポート元", "netflix", "title\nMovie\n"},
+		{"空", SourceWatchlist, ""},
+		{"タイトル列なし", SourceLetterboxd, "Year,Rating\n2020,4\n"},
+		{"行数の上限", SourceWatchlist, "title\n" + strings.Repeat("Movie\n", MaxRows+1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Parse(strings.NewReader(tt.csv), tt.source); err == nil {
+				t.Error("Parse succeeded, want error")
+			}
+		})
+	}
+}
+
+// BOM 付きのヘッダーと空行
+func TestParseBOMAndBlankLines(t *testing.T) {
+	rows, err := Parse(strings.NewReader("\xef\xbb\xbftitle,rating\nA,3\n,\nB,4\n"), SourceWatchlist)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 2 || rows[0].Title != "A" || rows[1].Title != "B" || rows[1].Line != 4 {
+		t.Errorf("got %+v %+v", rows[0], rows[len(rows)-1])
+	}
+}
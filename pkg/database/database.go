@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"watchlist-app/ent"
+	_ "watchlist-app/ent/runtime"
 	"watchlist-app/pkg/config"
 
 	_ "entgo.io/ent/dialect"
@@ -41,7 +42,21 @@ func New(cfg *config.Config) (*Database, error) {
 
 func (d *Database) AutoMigrate(ctx context.Context) error {
 	log.Println("Running database migrations...")
-	return d.Client.Schema.Create(ctx)
+	if err := d.Client.Schema.Create(ctx); err != nil {
+		return err
+	}
+	if err := createSearchIndexes(ctx, d.Client); err != nil {
+		return err
+	}
+
+	// データ移行
+	if err := runOnce(ctx, d.Client, "genres_to_tags", migrateGenresToTags); err != nil {
+		return err
+	}
+	if err := migrateWatchedAtToEvents(ctx, d.Client); err != nil {
+		return err
+	}
+	return seedPlatforms(ctx, d.Client)
 }
 
 func (d *Database) Close() error {
@@ -0,0 +1,159 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"watchlist-app/ent"
+	"watchlist-app/ent/movie"
+	"watchlist-app/ent/tag"
+)
+
+// タグ名の最大文字数（ent/schema/tag.go と同じ）
+const maxTagNameLength = 50
+
+// 実行済みのデータ移行を記録するテーブル
+const createDataMigrationsTable = `CREATE TABLE IF NOT EXISTS data_migrations (
+	name text PRIMARY KEY,
+	applied_at timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP
+)`
+
+// 一度だけ実行するデータ移行
+// 移行とその記録を1つのトランザクションで行い、記録済みの場合は何もしない
+func runOnce(ctx context.Context, client *ent.Client, name string, migrate func(context.Context, *ent.Client) error) error {
+	if _, err := client.ExecContext(ctx, createDataMigrationsTable); err != nil {
+		return fmt.Errorf("failed creating data_migrations table: %w", err)
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed starting data migration %s: %w", name, err)
+	}
+
+	res, err := tx.ExecContext(ctx,
+		`INSERT INTO data_migrations (name) VALUES ($1) ON CONFLICT (name) DO NOTHING`, name)
+	if err != nil {
+		return rollback(tx, fmt.Errorf("failed recording data migration %s: %w", name, err))
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return rollback(tx, err)
+	} else if n == 0 {
+		// 実行済み
+		return tx.Rollback()
+	}
+
+	if err := migrate(ctx, tx.Client()); err != nil {
+		return rollback(tx, err)
+	}
+	return tx.Commit()
+}
+
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		return fmt.Errorf("%w: rolling back: %v", err, rerr)
+	}
+	return err
+}
+
+// 視聴履歴導入前に記録された watched_at を最初の視聴記録として移行する（履歴がある作品はスキップ）
+func migrateWatchedAtToEvents(ctx context.Context, client *ent.Client) error {
+	movies, err := client.Movie.Query().
+		Where(
+			movie.WatchedAtNotNil(),
+			movie.Not(movie.HasWatchEvents()),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed querying movies without watch history: %w", err)
+	}
+
+	for _, m := range movies {
+		err := client.WatchEvent.Create().
+			SetMovieID(m.ID).
+			SetWatchedAt(m.WatchedAt).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed creating watch event for movie %d: %w", m.ID, err)
+		}
+	}
+
+	if len(movies) > 0 {
+		log.Printf("Migrated watched_at to watch history for %d movies", len(movies))
+	}
+	return nil
+}
+
+// 既存の genre 文字列をタグへ移行する（runOnce で一度だけ実行する）
+// 移行後にタグを外した作品に再び付けないよう、起動のたびには実行しない
+// タグが付いている作品は移行前に手動で分類したものとしてスキップする
+func migrateGenresToTags(ctx context.Context, client *ent.Client) error {
+	movies, err := client.Movie.Query().
+		Where(
+			movie.GenreNotNil(),
+			movie.GenreNEQ(""),
+			movie.UserIDNotNil(),
+			movie.Not(movie.HasTags()),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed querying movies with genre: %w", err)
+	}
+
+	migrated := 0
+	for _, m := range movies {
+		names := genreTagNames(m)
+		if len(names) == 0 {
+			continue
+		}
+
+		tags := make([]*ent.Tag, 0, len(names))
+		for _, name := range names {
+			t, err := client.Tag.Query().
+				Where(tag.UserIDEQ(m.UserID), tag.NameEQ(name)).
+				Only(ctx)
+			if ent.IsNotFound(err) {
+				t, err = client.Tag.Create().
+					SetUserID(m.UserID).
+					SetName(name).
+					Save(ctx)
+			}
+			if err != nil {
+				return fmt.Errorf("failed resolving tag %q: %w", name, err)
+			}
+			tags = append(tags, t)
+		}
+
+		if err := client.Movie.UpdateOne(m).AddTags(tags...).Exec(ctx); err != nil {
+			return fmt.Errorf("failed tagging movie %d: %w", m.ID, err)
+		}
+		migrated++
+	}
+
+	if migrated > 0 {
+		log.Printf("Migrated genre to tags for %d movies", migrated)
+	}
+	return nil
+}
+
+// 移行前の genre は "SF, Action" のように複数のジャンルをカンマ区切りで持つことがあるため、
+// タグ名に使えないカンマで分割して1ジャンル1タグにする
+// タグ名の上限を超えるジャンルは移行しない（genre の値はそのまま残る）
+func genreTagNames(m *ent.Movie) []string {
+	var names []string
+	for _, part := range strings.Split(m.Genre, ",") {
+		name := strings.TrimSpace(part)
+		if name == "" || slices.Contains(names, name) {
+			continue
+		}
+		if utf8.RuneCountInString(name) > maxTagNameLength {
+			log.Printf("Skipped migrating genre %q of movie %d to tags: longer than %d characters", name, m.ID, maxTagNameLength)
+			continue
+		}
+		names = append(names, name)
+	}
+	return names
+}
@@ -0,0 +1,74 @@
+package database
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"watchlist-app/ent"
+	"watchlist-app/ent/enttest"
+	"watchlist-app/ent/tag"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestMigrateGenresToTags(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	u := client.User.Create().
+		SetEmail("user@example.com").
+		SetPasswordHash("hash").
+		SetName("user").
+		SaveX(ctx)
+	newMovie := func(genre string) *ent.Movie {
+		return client.Movie.Create().
+			SetOwnerID(u.ID).
+			SetTitle("Movie").
+			SetGenre(genre).
+			SaveX(ctx)
+	}
+	// 既に同名のタグがあれば使う
+	existing := client.Tag.Create().SetUserID(u.ID).SetName("SF").SaveX(ctx)
+
+	multi := newMovie("SF, Action ,,SF")
+	single := newMovie("ドラマ")
+	long := newMovie("アニメ," + strings.Repeat("長", maxTagNameLength+1))
+	tagged := newMovie("ホラー")
+	client.Movie.UpdateOne(tagged).AddTags(existing).ExecX(ctx)
+
+	if err := runOnce(ctx, client, "genres_to_tags", migrateGenresToTags); err != nil {
+		t.Fatalf("migration failed: %v", err)
+	}
+
+	tests := []struct {
+		movie *ent.Movie
+		want  []string
+	}{
+		{multi, []string{"Action", "SF"}},
+		{single, []string{"ドラマ"}},
+		// 上限を超えるジャンルのみ移行しない
+		{long, []string{"アニメ"}},
+		// タグが付いている作品はスキップする
+		{tagged, []string{"SF"}},
+	}
+	for _, tt := range tests {
+		got := client.Movie.QueryTags(tt.movie).Order(ent.Asc(tag.FieldName)).Select(tag.FieldName).StringsX(ctx)
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("tags of %q = %v, want %v", tt.movie.Genre, got, tt.want)
+		}
+	}
+	if n := client.Tag.Query().Where(tag.NameEQ("SF")).CountX(ctx); n != 1 {
+		t.Errorf("got %d SF tags, want 1", n)
+	}
+
+	// 2回目以降は実行しない（移行後に外したタグを付け直さない）
+	client.Movie.UpdateOne(single).ClearTags().ExecX(ctx)
+	if err := runOnce(ctx, client, "genres_to_tags", migrateGenresToTags); err != nil {
+		t.Fatalf("second run failed: %v", err)
+	}
+	if n := client.Movie.QueryTags(single).CountX(ctx); n != 0 {
+		t.Errorf("second run tagged the movie again (%d tags)", n)
+	}
+}
@@ -0,0 +1,27 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"watchlist-app/ent"
+)
+
+// キーワード検索（ILIKE による部分一致）用の pg_trgm インデックス
+// トライグラムは文字単位で作られるため、単語の区切りがない日本語でも3文字以上の検索語ならインデックスが使われる
+var searchIndexStatements = []string{
+	`CREATE EXTENSION IF NOT EXISTS pg_trgm`,
+	`CREATE INDEX IF NOT EXISTS movies_title_trgm_idx ON movies USING gin (title gin_trgm_ops)`,
+	`CREATE INDEX IF NOT EXISTS movies_description_trgm_idx ON movies USING gin (description gin_trgm_ops)`,
+	`CREATE INDEX IF NOT EXISTS movies_review_trgm_idx ON movies USING gin (review gin_trgm_ops)`,
+}
+
+// 検索用インデックスを作成する（先に pg_trgm 拡張の作成が必要なため、スキーマ定義ではなく SQL で作成する）
+func createSearchIndexes(ctx context.Context, client *ent.Client) error {
+	for _, stmt := range searchIndexStatements {
+		if _, err := client.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("failed creating search index: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"watchlist-app/ent"
+	"watchlist-app/ent/platform"
+)
+
+// 初期登録する配信サービス（識別子 → 表示名）
+var defaultPlatforms = []struct {
+	Slug string
+	Name string
+}{
+	{"netflix", "Netflix"},
+	{"prime_video", "Prime Video"},
+	{"u_next", "U-NEXT"},
+	{"disney_plus", "Disney+"},
+	{"hulu", "Hulu"},
+	{"apple_tv", "Apple TV+"},
+	{"abema", "ABEMA"},
+	{"d_anime", "dアニメストア"},
+}
+
+// 未登録の既定の配信サービスを追加する（既存のものは変更しない）
+func seedPlatforms(ctx context.Context, client *ent.Client) error {
+	existing, err := client.Platform.Query().
+		Select(platform.FieldSlug).
+		Strings(ctx)
+	if err != nil {
+		return fmt.Errorf("failed querying platforms: %w", err)
+	}
+	found := make(map[string]bool, len(existing))
+	for _, slug := range existing {
+		found[slug] = true
+	}
+
+	created := 0
+	for _, p := range defaultPlatforms {
+		if found[p.Slug] {
+			continue
+		}
+		if err := client.Platform.Create().SetSlug(p.Slug).SetName(p.Name).Exec(ctx); err != nil {
+			return fmt.Errorf("failed creating platform %s: %w", p.Slug, err)
+		}
+		created++
+	}
+
+	if created > 0 {
+		log.Printf("Seeded %d streaming platforms", created)
+	}
+	return nil
+}
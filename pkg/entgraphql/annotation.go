@@ -0,0 +1,51 @@
+// Package entgraphql は ent のスキーマから GraphQL の型定義を生成するためのアノテーション
+// （entgql の Type・Skip に相当）を提供する。生成は pkg/entgraphql/sdl で ent のコード生成時に行う
+package entgraphql
+
+import "entgo.io/ent/schema"
+
+// Annotation は ent のスキーマ・フィールド・エッジに付ける GraphQL の設定
+type Annotation struct {
+	// スキーマ: この名前の GraphQL の型を生成する（指定のないスキーマは生成しない）
+	// enum フィールド: 列挙型の名前（省略時は型名 + フィールド名）
+	Type string `json:"Type,omitempty"`
+	// フィールド・エッジ: GraphQL に公開しない
+	Skip bool `json:"Skip,omitempty"`
+}
+
+// Name implements the schema.Annotation interface.
+func (Annotation) Name() string {
+	return "EntGraphQL"
+}
+
+// Merge implements the schema.Merger interface.
+func (a Annotation) Merge(other schema.Annotation) schema.Annotation {
+	var o Annotation
+	switch other := other.(type) {
+	case Annotation:
+		o = other
+	case *Annotation:
+		if other != nil {
+			o = *other
+		}
+	default:
+		return a
+	}
+	if o.Type != "" {
+		a.Type = o.Type
+	}
+	a.Skip = a.Skip || o.Skip
+	return a
+}
+
+// Type はスキーマを GraphQL の型として生成する、または enum フィールドの列挙型の名前を指定する
+func Type(name string) Annotation {
+	return Annotation{Type: name}
+}
+
+// Skip はフィールド・エッジを GraphQL に公開しない
+func Skip() Annotation {
+	return Annotation{Skip: true}
+}
+
+var _ schema.Merger = Annotation{}
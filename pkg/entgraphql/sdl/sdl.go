@@ -0,0 +1,194 @@
+// Package sdl は entgraphql のアノテーションを付けた ent のスキーマから GraphQL の型定義（SDL）を生成する
+// ent のコード生成（ent/entc.go）に Hook を登録して使う
+package sdl
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/schema/field"
+
+	"watchlist-app/pkg/entgraphql"
+)
+
+// 生成するファイルの先頭
+const header = `# Code generated by ent, DO NOT EDIT.
+# ent/schema の entgraphql アノテーションから生成する。手書きの定義は schema.graphqls に置く
+`
+
+// Hook は ent のコード生成の後に、Render の結果を path に書き出す
+func Hook(path string) gen.Hook {
+	return func(next gen.Generator) gen.Generator {
+		return gen.GenerateFunc(func(g *gen.Graph) error {
+			if err := next.Generate(g); err != nil {
+				return err
+			}
+			b, err := Render(g)
+			if err != nil {
+				return err
+			}
+			return os.WriteFile(path, b, 0o644)
+		})
+	}
+}
+
+// Render は entgraphql.Type を付けたスキーマの GraphQL の型と、そのフィールドの列挙型を返す
+// entgraphql.Skip を付けたフィールド・エッジと、生成しない型へのエッジは含めない
+func Render(g *gen.Graph) ([]byte, error) {
+	types := map[*gen.Type]string{}
+	for _, t := range g.Nodes {
+		a, err := annotation(t.Annotations)
+		if err != nil {
+			return nil, fmt.Errorf("entgraphql: %s: %w", t.Name, err)
+		}
+		if a.Type != "" {
+			types[t] = a.Type
+		}
+	}
+
+	nodes := make([]*gen.Type, 0, len(types))
+	for t := range types {
+		nodes = append(nodes, t)
+	}
+	slices.SortFunc(nodes, func(a, b *gen.Type) int { return strings.Compare(types[a], types[b]) })
+
+	enums := map[string][]string{}
+	var defs []string
+	for _, t := range nodes {
+		def, err := renderType(t, types, enums)
+		if err != nil {
+			return nil, fmt.Errorf("entgraphql: %s: %w", t.Name, err)
+		}
+		defs = append(defs, def)
+	}
+
+	var b bytes.Buffer
+	b.WriteString(header)
+	names := make([]string, 0, len(enums))
+	for name := range enums {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		fmt.Fprintf(&b, "\nenum %s {\n", name)
+		for _, v := range enums[name] {
+			fmt.Fprintf(&b, "  %s\n", v)
+		}
+		b.WriteString("}\n")
+	}
+	for _, def := range defs {
+		b.WriteString("\n" + def)
+	}
+	return b.Bytes(), nil
+}
+
+func renderType(t *gen.Type, types map[*gen.Type]string, enums map[string][]string) (string, error) {
+	var b strings.Builder
+	fmt.Fprintf(&b, "type %s {\n", types[t])
+	b.WriteString("  id: ID!\n")
+
+	for _, f := range t.Fields {
+		a, err := annotation(f.Annotations)
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", f.Name, err)
+		}
+		if a.Skip {
+			continue
+		}
+		typ, err := fieldType(t, f, a, enums)
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", f.Name, err)
+		}
+		if !f.Optional && !f.Nillable {
+			typ += "!"
+		}
+		if c := f.Comment(); c != "" {
+			fmt.Fprintf(&b, "  # %s\n", c)
+		}
+		fmt.Fprintf(&b, "  %s: %s\n", camel(f.Name), typ)
+	}
+
+	for _, e := range t.Edges {
+		a, err := annotation(e.Annotations)
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", e.Name, err)
+		}
+		name, ok := types[e.Type]
+		if a.Skip || !ok {
+			continue
+		}
+		typ := "[" + name + "!]!"
+		if e.Unique {
+			typ = name
+			if !e.Optional {
+				typ += "!"
+			}
+		}
+		fmt.Fprintf(&b, "  %s: %s\n", camel(e.Name), typ)
+	}
+
+	b.WriteString("}\n")
+	return b.String(), nil
+}
+
+// ent のフィールドの型に対応する GraphQL の型（enum は列挙型を enums に加える）
+func fieldType(t *gen.Type, f *gen.Field, a entgraphql.Annotation, enums map[string][]string) (string, error) {
+	switch {
+	case f.Type.Type == field.TypeEnum:
+		name := a.Type
+		if name == "" {
+			name = t.Name + f.StructField()
+		}
+		values := make([]string, len(f.Enums))
+		for i, e := range f.Enums {
+			values[i] = e.Value
+		}
+		if prev, ok := enums[name]; ok && !slices.Equal(prev, values) {
+			return "", fmt.Errorf("列挙型 %s の値が他のフィールドと異なります", name)
+		}
+		enums[name] = values
+		return name, nil
+	case f.Type.Type == field.TypeString:
+		return "String", nil
+	case f.Type.Type == field.TypeBool:
+		return "Boolean", nil
+	case f.Type.Type == field.TypeTime:
+		return "Time", nil
+	case f.Type.Numeric() && strings.HasPrefix(f.Type.Type.String(), "float"):
+		return "Float", nil
+	case f.Type.Numeric():
+		return "Int", nil
+	}
+	return "", fmt.Errorf("GraphQL の型に対応していない型 %s です", f.Type)
+}
+
+// Annotations から entgraphql の設定を読み込む
+func annotation(annotations gen.Annotations) (entgraphql.Annotation, error) {
+	var a entgraphql.Annotation
+	v, ok := annotations[a.Name()]
+	if !ok {
+		return a, nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return a, err
+	}
+	err = json.Unmarshal(b, &a)
+	return a, err
+}
+
+// ent のフィールド名（snake_case）を GraphQL のフィールド名（camelCase）にする
+func camel(s string) string {
+	parts := strings.Split(s, "_")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
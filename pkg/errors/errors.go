@@ -38,3 +38,31 @@ func NewInternalServerError(message string) *AppError {
 	}
 	return NewAppError(http.StatusInternalServerError, message)
 }
+
+func NewUnauthorizedError(message string) *AppError {
+	if message == "" {
+		message = "認証が必要です"
+	}
+	return NewAppError(http.StatusUnauthorized, message)
+}
+
+func NewConflictError(message string) *AppError {
+	if message == "" {
+		message = "リソースが競合しています"
+	}
+	return NewAppError(http.StatusConflict, message)
+}
+
+func NewPreconditionRequiredError(message string) *AppError {
+	if message == "" {
+		message = "If-Match ヘッダーが必要です"
+	}
+	return NewAppError(http.StatusPreconditionRequired, message)
+}
+
+func NewPreconditionFailedError(message string) *AppError {
+	if message == "" {
+		message = "リソースが更新されています"
+	}
+	return NewAppError(http.StatusPreconditionFailed, message)
+}
@@ -0,0 +1,357 @@
+package filterquery
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// 入れ子の括弧・否定の上限（深すぎる入れ子による再帰の暴走を防ぐ）
+const maxDepth = 32
+
+// 比較演算子
+type Op string
+
+const (
+	OpEq    Op = ":"
+	OpNe    Op = "!="
+	OpGt    Op = ">"
+	OpGte   Op = ">="
+	OpLt    Op = "<"
+	OpLte   Op = "<="
+	OpRange Op = ".."
+)
+
+// 構文木のノード（And / Or / Not / Cond）
+type Node interface {
+	node()
+}
+
+// 全ての条件を満たす
+type And struct {
+	Nodes []Node
+}
+
+// いずれかの条件を満たす
+type Or struct {
+	Nodes []Node
+}
+
+// 条件を満たさない
+type Not struct {
+	Node Node
+}
+
+// 項目に対する1つの条件（genre:SF、rating>=4、year:2010..2020 など）
+// 範囲指定（OpRange）の場合は Value が下限、To が上限で、省略された側は空文字になる
+type Cond struct {
+	Field string
+	Op    Op
+	Value string
+	To    string
+	// 項目名・値の開始位置（1始まりの文字数）
+	Pos      int
+	ValuePos int
+}
+
+func (*And) node()  {}
+func (*Or) node()   {}
+func (*Not) node()  {}
+func (*Cond) node() {}
+
+// 解析エラー。Pos は問題のある箇所の位置（1始まりの文字数）
+type SyntaxError struct {
+	Pos int
+	Msg string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("%d文字目: %s", e.Pos, e.Msg)
+}
+
+// フィルタ式を構文木に変換する
+//
+//	query = or
+//	or    = and { "OR" and }
+//	and   = unary { [ "AND" ] unary }
+//	unary = ( "-" | "NOT" ) unary | "(" or ")" | cond
+//	cond  = field ( ":" | "!=" | ">" | ">=" | "<" | "<=" ) value
+//
+// 空白で区切った条件は AND で結合され、":" の値に ".." を含む場合は範囲指定（両端を含む）になる。
+// 空白を含む値は "..." で囲む。
+func Parse(input string) (Node, error) {
+	p := &parser{src: []rune(input)}
+	p.skipSpace()
+	if p.eof() {
+		return nil, p.errorf(p.pos, "条件が指定されていません")
+	}
+	n, err := p.parseOr(0)
+	if err != nil {
+		return nil, err
+	}
+	if !p.eof() {
+		if p.peek() == ')' {
+			return nil, p.errorf(p.pos, "対応する ( がありません")
+		}
+		return nil, p.errorf(p.pos, "条件を解析できません")
+	}
+	return n, nil
+}
+
+type parser struct {
+	src []rune
+	pos int
+}
+
+func (p *parser) eof() bool {
+	return p.pos >= len(p.src)
+}
+
+func (p *parser) peek() rune {
+	return p.src[p.pos]
+}
+
+func (p *parser) skipSpace() {
+	for !p.eof() && unicode.IsSpace(p.peek()) {
+		p.pos++
+	}
+}
+
+// pos は 0 始まりの位置。エラーには 1 始まりで記録する
+func (p *parser) errorf(pos int, format string, args ...any) error {
+	return &SyntaxError{Pos: pos + 1, Msg: fmt.Sprintf(format, args...)}
+}
+
+// 現在位置が単独のキーワード（OR・AND・NOT）の場合はその長さを返す
+func (p *parser) keyword(word string) int {
+	end := p.pos + len(word)
+	if end > len(p.src) || !strings.EqualFold(string(p.src[p.pos:end]), word) {
+		return 0
+	}
+	if end < len(p.src) && !unicode.IsSpace(p.src[end]) && p.src[end] != '(' {
+		return 0
+	}
+	return len(word)
+}
+
+func (p *parser) parseOr(depth int) (Node, error) {
+	first, err := p.parseAnd(depth)
+	if err != nil {
+		return nil, err
+	}
+	nodes := []Node{first}
+	for {
+		p.skipSpace()
+		n := p.keyword("OR")
+		if n == 0 {
+			break
+		}
+		p.pos += n
+		p.skipSpace()
+		if p.eof() || p.peek() == ')' {
+			return nil, p.errorf(p.pos, "OR の後に条件がありません")
+		}
+		next, err := p.parseAnd(depth)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, next)
+	}
+	if len(nodes) == 1 {
+		return first, nil
+	}
+	return &Or{Nodes: nodes}, nil
+}
+
+func (p *parser) parseAnd(depth int) (Node, error) {
+	var nodes []Node
+	for {
+		p.skipSpace()
+		if p.eof() || p.peek() == ')' || p.keyword("OR") > 0 {
+			break
+		}
+		if n := p.keyword("AND"); n > 0 {
+			if len(nodes) == 0 {
+				return nil, p.errorf(p.pos, "AND の前に条件がありません")
+			}
+			p.pos += n
+			p.skipSpace()
+			if p.eof() || p.peek() == ')' || p.keyword("OR") > 0 {
+				return nil, p.errorf(p.pos, "AND の後に条件がありません")
+			}
+		}
+		n, err := p.parseUnary(depth)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, n)
+	}
+	switch len(nodes) {
+	case 0:
+		if !p.eof() && p.keyword("OR") > 0 {
+			return nil, p.errorf(p.pos, "OR の前に条件がありません")
+		}
+		return nil, p.errorf(p.pos, "条件がありません")
+	case 1:
+		return nodes[0], nil
+	}
+	return &And{Nodes: nodes}, nil
+}
+
+func (p *parser) parseUnary(depth int) (Node, error) {
+	if depth >= maxDepth {
+		return nil, p.errorf(p.pos, "括弧・否定の入れ子が深すぎます")
+	}
+
+	switch {
+	case p.peek() == '-':
+		p.pos++
+		if p.eof() || unicode.IsSpace(p.peek()) {
+			return nil, p.errorf(p.pos, "- の後に条件がありません")
+		}
+		n, err := p.parseUnary(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		return &Not{Node: n}, nil
+	case p.keyword("NOT") > 0:
+		p.pos += p.keyword("NOT")
+		p.skipSpace()
+		if p.eof() || p.peek() == ')' {
+			return nil, p.errorf(p.pos, "NOT の後に条件がありません")
+		}
+		n, err := p.parseUnary(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		return &Not{Node: n}, nil
+	case p.peek() == '(':
+		open := p.pos
+		p.pos++
+		p.skipSpace()
+		if !p.eof() && p.peek() == ')' {
+			return nil, p.errorf(open, "括弧の中に条件がありません")
+		}
+		n, err := p.parseOr(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpace()
+		if p.eof() || p.peek() != ')' {
+			return nil, p.errorf(open, "対応する ) がありません")
+		}
+		p.pos++
+		return n, nil
+	}
+	return p.parseCond()
+}
+
+func (p *parser) parseCond() (Node, error) {
+	start := p.pos
+	for !p.eof() && isFieldRune(p.peek()) {
+		p.pos++
+	}
+	if p.pos == start {
+		return nil, p.errorf(start, "項目名がありません")
+	}
+	name := string(p.src[start:p.pos])
+	cond := &Cond{Field: strings.ToLower(name), Pos: start + 1}
+
+	opPos := p.pos
+	cond.Op = p.parseOp()
+	if cond.Op == "" {
+		return nil, p.errorf(opPos, "%s の後に演算子（: != > >= < <=）がありません", name)
+	}
+
+	cond.ValuePos = p.pos + 1
+	value, quoted, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+	if value == "" && !quoted {
+		return nil, p.errorf(p.pos, "%s の値がありません", cond.Field)
+	}
+	cond.Value = value
+
+	// 範囲指定（year:2010..2020、rating:4.. など）
+	if cond.Op == OpEq && !quoted {
+		if from, to, ok := strings.Cut(value, ".."); ok {
+			if from == "" && to == "" {
+				return nil, p.errorf(cond.ValuePos-1, "範囲の下限か上限を指定してください")
+			}
+			cond.Op, cond.Value, cond.To = OpRange, from, to
+		}
+	}
+	return cond, nil
+}
+
+func (p *parser) parseOp() Op {
+	if p.eof() {
+		return ""
+	}
+	next := func(r rune) bool {
+		return p.pos+1 < len(p.src) && p.src[p.pos+1] == r
+	}
+	switch p.peek() {
+	case ':':
+		p.pos++
+		return OpEq
+	case '!':
+		if next('=') {
+			p.pos += 2
+			return OpNe
+		}
+	case '>':
+		if next('=') {
+			p.pos += 2
+			return OpGte
+		}
+		p.pos++
+		return OpGt
+	case '<':
+		if next('=') {
+			p.pos += 2
+			return OpLte
+		}
+		p.pos++
+		return OpLt
+	}
+	return ""
+}
+
+// 値を読み取る。"..." で囲まれた値は \" と \\ をエスケープとして扱う
+func (p *parser) parseValue() (string, bool, error) {
+	if !p.eof() && p.peek() == '"' {
+		open := p.pos
+		p.pos++
+		var b strings.Builder
+		for !p.eof() {
+			r := p.peek()
+			p.pos++
+			switch {
+			case r == '"':
+				return b.String(), true, nil
+			case r == '\\' && !p.eof():
+				b.WriteRune(p.peek())
+				p.pos++
+			default:
+				b.WriteRune(r)
+			}
+		}
+		return "", false, p.errorf(open, "閉じる \" がありません")
+	}
+
+	start := p.pos
+	for !p.eof() {
+		r := p.peek()
+		if unicode.IsSpace(r) || r == '(' || r == ')' || r == '"' {
+			break
+		}
+		p.pos++
+	}
+	return string(p.src[start:p.pos]), false, nil
+}
+
+func isFieldRune(r rune) bool {
+	return r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+}
@@ -0,0 +1,150 @@
+package filterquery
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// 構文木を比較しやすい文字列にする
+func format(n Node) string {
+	switch n := n.(type) {
+	case *And:
+		return "(and " + formatNodes(n.Nodes) + ")"
+	case *Or:
+		return "(or " + formatNodes(n.Nodes) + ")"
+	case *Not:
+		return "(not " + format(n.Node) + ")"
+	case *Cond:
+		if n.Op == OpRange {
+			return fmt.Sprintf("%s[%s..%s]", n.Field, n.Value, n.To)
+		}
+		return fmt.Sprintf("%s%s%q", n.Field, n.Op, n.Value)
+	}
+	return fmt.Sprintf("%T", n)
+}
+
+func formatNodes(nodes []Node) string {
+	s := make([]string, len(nodes))
+	for i, n := range nodes {
+		s[i] = format(n)
+	}
+	return strings.Join(s, " ")
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{`genre:SF`, `genre:"SF"`},
+		{`Genre:SF`, `genre:"SF"`},
+		{`rating>=4`, `rating>="4"`},
+		{`rating>4 rating<5 rating<=3 genre!=SF`, `(and rating>"4" rating<"5" rating<="3" genre!="SF")`},
+		{`genre:SF rating>=4`, `(and genre:"SF" rating>="4")`},
+		{`genre:SF AND rating>=4`, `(and genre:"SF" rating>="4")`},
+		{`genre:SF and rating>=4`, `(and genre:"SF" rating>="4")`},
+		// AND は OR より優先される
+		{`genre:SF rating>=4 OR genre:ドラマ`, `(or (and genre:"SF" rating>="4") genre:"ドラマ")`},
+		{`genre:SF OR genre:ドラマ OR genre:アニメ`, `(or genre:"SF" genre:"ドラマ" genre:"アニメ")`},
+		{`(genre:SF OR genre:ドラマ) rating>=4`, `(and (or genre:"SF" genre:"ドラマ") rating>="4")`},
+		{`-media_type:anime`, `(not media_type:"anime")`},
+		{`NOT media_type:anime`, `(not media_type:"anime")`},
+		{`NOT(genre:SF OR genre:ドラマ)`, `(not (or genre:"SF" genre:"ドラマ"))`},
+		{`--genre:SF`, `(not (not genre:"SF"))`},
+		// 範囲指定
+		{`year:2010..2020`, `year[2010..2020]`},
+		{`rating:4..`, `rating[4..]`},
+		{`year:..2010`, `year[..2010]`},
+		// 引用符で囲んだ値（空白・括弧・エスケープ、範囲として扱わない）
+		{`title:"star wars"`, `title:"star wars"`},
+		{`title:"a (b) OR c"`, `title:"a (b) OR c"`},
+		{`title:"say \"hi\" \\o/"`, `title:"say \"hi\" \\o/"`},
+		{`title:"1..2"`, `title:"1..2"`},
+		{`title:""`, `title:""`},
+		// キーワードは単独の語のみ
+		{`genre:ORIGINAL`, `genre:"ORIGINAL"`},
+		{`genre:SF ORDER:1`, `(and genre:"SF" order:"1")`},
+		{`  genre:SF  `, `genre:"SF"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			n, err := Parse(tt.input)
+			if err != nil {
+				t.Fatalf("Parse(%q) error: %v", tt.input, err)
+			}
+			if got := format(n); got != tt.want {
+				t.Errorf("Parse(%q) = %s, want %s", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParsePositions(t *testing.T) {
+	// 全角文字も1文字として数える
+	n, err := Parse(`title:"東京" rating>=4`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := n.(*And).Nodes[1].(*Cond)
+	if c.Pos != 12 || c.ValuePos != 20 {
+		t.Errorf("Pos, ValuePos = %d, %d, want 12, 20", c.Pos, c.ValuePos)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		input string
+		pos   int
+		msg   string
+	}{
+		{``, 1, "条件が指定されていません"},
+		{`   `, 4, "条件が指定されていません"},
+		{`genre`, 6, "genre の後に演算子"},
+		{`genre=SF`, 6, "genre の後に演算子"},
+		{`genre!SF`, 6, "genre の後に演算子"},
+		{`genre:`, 7, "genre の値がありません"},
+		{`rating>= 4`, 9, "rating の値がありません"},
+		{`year:..`, 6, "範囲の下限か上限を指定してください"},
+		{`title:"abc`, 7, `閉じる " がありません`},
+		{`:SF`, 1, "項目名がありません"},
+		{`genre:SF )`, 10, "対応する ( がありません"},
+		{`(genre:SF`, 1, "対応する ) がありません"},
+		{`genre:SF (rating>=4`, 10, "対応する ) がありません"},
+		{`()`, 1, "括弧の中に条件がありません"},
+		{`genre:SF OR`, 12, "OR の後に条件がありません"},
+		{`(genre:SF OR )`, 14, "OR の後に条件がありません"},
+		{`OR genre:SF`, 1, "OR の前に条件がありません"},
+		{`AND genre:SF`, 1, "AND の前に条件がありません"},
+		{`genre:SF AND`, 13, "AND の後に条件がありません"},
+		{`genre:SF AND OR rating:4`, 14, "AND の後に条件がありません"},
+		{`- genre:SF`, 2, "- の後に条件がありません"},
+		{`NOT`, 4, "NOT の後に条件がありません"},
+		{`(NOT )`, 6, "NOT の後に条件がありません"},
+		// 全角文字も1文字として数える
+		{`ジャンル:SF`, 1, "項目名がありません"},
+		{`title:"東京" rating`, 18, "rating の後に演算子"},
+		{strings.Repeat("(", maxDepth) + "genre:SF" + strings.Repeat(")", maxDepth), maxDepth + 1, "入れ子が深すぎます"},
+		{strings.Repeat("-", maxDepth+1) + "genre:SF", maxDepth + 1, "入れ子が深すぎます"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			_, err := Parse(tt.input)
+			var se *SyntaxError
+			if !errors.As(err, &se) {
+				t.Fatalf("Parse(%q) error = %v, want *SyntaxError", tt.input, err)
+			}
+			if se.Pos != tt.pos || !strings.Contains(se.Msg, tt.msg) {
+				t.Errorf("Parse(%q) = %d文字目: %s, want %d文字目: %s", tt.input, se.Pos, se.Msg, tt.pos, tt.msg)
+			}
+		})
+	}
+}
+
+func TestSyntaxErrorMessage(t *testing.T) {
+	_, err := Parse(`genre:SF OR`)
+	if got, want := err.Error(), "12文字目: OR の後に条件がありません"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,56 @@
+package pagination
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+const (
+	DefaultLimit = 20
+	MaxLimit     = 100
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// キーセットページネーションの位置（前のページの最後の行のソートキー）
+// クライアントには中身を意識させないよう、エンコードした文字列として渡す
+type Cursor struct {
+	// カーソル作成時の並び順。異なる並び順のリクエストでは使えない
+	Sort string `json:"s"`
+	// 並び順の各キーの値（NULL は nil）。数値は json.Number として復元される
+	Values []any `json:"v"`
+	// 同じ値が並んだ時の順序を決める ID
+	ID int `json:"i"`
+}
+
+// カーソルを URL に含められる文字列にエンコードする
+func Encode(c Cursor) string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// Encode で作成した文字列からカーソルを復元する
+func Decode(s string) (Cursor, error) {
+	var c Cursor
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, ErrInvalidCursor
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err := dec.Decode(&c); err != nil || c.ID <= 0 {
+		return c, ErrInvalidCursor
+	}
+	return c, nil
+}
+
+// 指定がない・範囲外の件数を補正する
+func NormalizeLimit(limit int) int {
+	if limit <= 0 {
+		return DefaultLimit
+	}
+	return min(limit, MaxLimit)
+}
@@ -0,0 +1,92 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+// 配信先として許可しないアドレスへの送信・登録時のエラー
+var ErrDisallowedAddress = errors.New("webhook: destination address is not allowed")
+
+// サーバー内部やプライベートネットワークのアドレスかどうか
+// ユーザーが登録した URL からこれらのアドレスに送信すると、外部から到達できないサービスを呼び出せてしまう
+func IsDisallowedAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return !addr.IsValid() ||
+		addr.IsLoopback() ||
+		addr.IsPrivate() ||
+		addr.IsLinkLocalUnicast() ||
+		addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() ||
+		addr.IsMulticast() ||
+		addr.IsUnspecified()
+}
+
+// URL のホストを名前解決し、許可しないアドレスが含まれる場合はエラーを返す（登録時の確認用）
+// 送信時にも NewHTTPClient の接続時の確認で同じ判定を行うため、登録後に名前解決の結果が変わっても送信されない
+func CheckURL(ctx context.Context, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	host := u.Hostname()
+	if host == "" {
+		return fmt.Errorf("webhook: missing host in %q", rawURL)
+	}
+
+	if addr, err := netip.ParseAddr(host); err == nil {
+		if IsDisallowedAddr(addr) {
+			return ErrDisallowedAddress
+		}
+		return nil
+	}
+
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return err
+	}
+	for _, addr := range addrs {
+		if IsDisallowedAddr(addr) {
+			return ErrDisallowedAddress
+		}
+	}
+	return nil
+}
+
+// 配信用の HTTP クライアント
+// 接続先のアドレスを接続の直前に確認し、リダイレクトには従わない（3xx は失敗として扱う）
+func NewHTTPClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			ap, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return err
+			}
+			if IsDisallowedAddr(ap.Addr()) {
+				return ErrDisallowedAddress
+			}
+			return nil
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// プロキシ経由では接続先の確認ができないため使わない
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
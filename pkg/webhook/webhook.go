@@ -0,0 +1,98 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strconv"
+	"time"
+
+	"watchlist-app/pkg/audit"
+)
+
+// 配信するイベントの種類
+const (
+	EventMovieCreated       = "movie.created"
+	EventMovieStatusChanged = "movie.status_changed"
+	EventMovieRated         = "movie.rated"
+	EventMovieDeleted       = "movie.deleted"
+)
+
+// 購読できるイベントの一覧
+var Events = []string{
+	EventMovieCreated,
+	EventMovieStatusChanged,
+	EventMovieRated,
+	EventMovieDeleted,
+}
+
+// 配信リクエストのヘッダー
+const (
+	HeaderEvent     = "X-Watchlist-Event"
+	HeaderDelivery  = "X-Watchlist-Delivery"
+	HeaderTimestamp = "X-Watchlist-Timestamp"
+	HeaderSignature = "X-Watchlist-Signature"
+)
+
+// 再送間隔の初期値と上限
+const (
+	initialBackoff = 30 * time.Second
+	maxBackoff     = 6 * time.Hour
+)
+
+// 配信する本文
+type Payload struct {
+	Event     string    `json:"event"`
+	CreatedAt time.Time `json:"created_at"`
+	// 操作したユーザーID（定期処理などシステムによる操作は nil）
+	ActorID *int  `json:"actor_id"`
+	Movie   Movie `json:"movie"`
+	// 更新系のイベントでのみ設定する
+	Changes audit.Changes `json:"changes,omitempty"`
+}
+
+// イベント発生時点の作品
+type Movie struct {
+	ID          int        `json:"id"`
+	Title       string     `json:"title"`
+	Genre       string     `json:"genre,omitempty"`
+	ReleaseYear int        `json:"release_year,omitempty"`
+	MediaType   string     `json:"media_type"`
+	WatchStatus string     `json:"watch_status"`
+	Rating      int        `json:"rating,omitempty"`
+	WatchedAt   *time.Time `json:"watched_at,omitempty"`
+	Version     int        `json:"version"`
+}
+
+// 署名（"sha256=" + タイムスタンプと本文を "." で連結した文字列の HMAC-SHA256）
+// 受信側は同じ計算をして HeaderSignature と比較し、古いタイムスタンプは拒否する
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// attempts 回失敗した後、次に再送するまでの間隔（30秒から倍々で増やし、6時間で頭打ち）
+func Backoff(attempts int) time.Duration {
+	d := initialBackoff
+	for i := 1; i < attempts; i++ {
+		d *= 2
+		if d >= maxBackoff {
+			return maxBackoff
+		}
+	}
+	return d
+}
+
+// ランダムな署名用シークレットを生成
+func NewSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
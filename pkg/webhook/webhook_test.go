@@ -0,0 +1,145 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+)
+
+func TestSign(t *testing.T) {
+	body := []byte(`{"event":"movie.created"}`)
+
+	// 受信側が行う検証と同じ計算
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte("1700000000." + string(body)))
+	want := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	if got := Sign("secret", 1700000000, body); got != want {
+		t.Errorf("Sign = %s, want %s", got, want)
+	}
+	if Sign("secret", 1700000001, body) == want {
+		t.Error("signature does not depend on the timestamp")
+	}
+	if Sign("other", 1700000000, body) == want {
+		t.Error("signature does not depend on the secret")
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{0, 30 * time.Second},
+		{1, 30 * time.Second},
+		{2, time.Minute},
+		{3, 2 * time.Minute},
+		{8, 64 * time.Minute},
+		{10, 256 * time.Minute},
+		// 6時間で頭打ち
+		{11, 6 * time.Hour},
+		{100, 6 * time.Hour},
+	}
+	for _, tt := range tests {
+		if got := Backoff(tt.attempts); got != tt.want {
+			t.Errorf("Backoff(%d) = %s, want %s", tt.attempts, got, tt.want)
+		}
+	}
+}
+
+func TestNewSecret(t *testing.T) {
+	a, err := NewSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := NewSecret()
+	if len(a) != 43 || a == b {
+		t.Errorf("NewSecret = %q, %q", a, b)
+	}
+}
+
+func TestIsDisallowedAddr(t *testing.T) {
+	tests := []struct {
+		addr       string
+		disallowed bool
+	}{
+		{"127.0.0.1", true},
+		{"127.1.2.3", true},
+		{"::1", true},
+		{"10.0.0.5", true},
+		{"172.16.0.1", true},
+		{"192.168.1.1", true},
+		{"fd00::1", true},
+		{"169.254.169.254", true},
+		{"fe80::1", true},
+		{"0.0.0.0", true},
+		{"::", true},
+		{"224.0.0.1", true},
+		// IPv4 射影アドレスも IPv4 として判定する
+		{"::ffff:127.0.0.1", true},
+		{"::ffff:169.254.169.254", true},
+		{"8.8.8.8", false},
+		{"93.184.216.34", false},
+		{"2606:4700:4700::1111", false},
+	}
+	for _, tt := range tests {
+		if got := IsDisallowedAddr(netip.MustParseAddr(tt.addr)); got != tt.disallowed {
+			t.Errorf("IsDisallowedAddr(%s) = %v, want %v", tt.addr, got, tt.disallowed)
+		}
+	}
+}
+
+func TestCheckURL(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		url     string
+		wantErr error
+	}{
+		{"http://127.0.0.1:8080/hook", ErrDisallowedAddress},
+		{"http://localhost/hook", ErrDisallowedAddress},
+		{"http://[::1]/hook", ErrDisallowedAddress},
+		{"http://169.254.169.254/latest/meta-data/", ErrDisallowedAddress},
+		{"https://8.8.8.8/hook", nil},
+	}
+	for _, tt := range tests {
+		if err := CheckURL(ctx, tt.url); !errors.Is(err, tt.wantErr) {
+			t.Errorf("CheckURL(%s) = %v, want %v", tt.url, err, tt.wantErr)
+		}
+	}
+}
+
+// 名前解決の結果によらず、接続時に内部のアドレスへの送信を拒否する
+func TestHTTPClientRejectsInternalAddresses(t *testing.T) {
+	called := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer srv.Close()
+
+	res, err := NewHTTPClient(time.Second).Post(srv.URL, "application/json", nil)
+	if err == nil {
+		res.Body.Close()
+	}
+	if !errors.Is(err, ErrDisallowedAddress) {
+		t.Errorf("Post error = %v, want %v", err, ErrDisallowedAddress)
+	}
+	if called {
+		t.Error("request reached the loopback server")
+	}
+}
+
+func TestHTTPClientDoesNotFollowRedirects(t *testing.T) {
+	client := NewHTTPClient(time.Second)
+	req := httptest.NewRequest(http.MethodPost, "https://example.com/hook", nil)
+	via := []*http.Request{httptest.NewRequest(http.MethodPost, "https://example.com/", nil)}
+	if err := client.CheckRedirect(req, via); !errors.Is(err, http.ErrUseLastResponse) {
+		t.Errorf("CheckRedirect = %v, want %v", err, http.ErrUseLastResponse)
+	}
+}